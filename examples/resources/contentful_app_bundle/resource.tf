@@ -0,0 +1,11 @@
+resource "contentful_app_bundle" "color_picker" {
+  app_definition_id = contentful_app_definition.color_picker.app_definition_id
+  file              = "${path.module}/build/color-picker.zip"
+  comment           = "Built from ${var.git_sha}"
+  activate          = true
+
+  # activate the new bundle before the previous one is destroyed
+  lifecycle {
+    create_before_destroy = true
+  }
+}
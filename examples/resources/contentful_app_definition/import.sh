@@ -0,0 +1,5 @@
+# App definitions can be imported using <organization_id>/<app_definition_id>
+terraform import contentful_app_definition.color_picker 0abc123/5Ji3ZnnIUhzDKxRhvMdOvT
+
+# or by app definition id alone when the provider organization_id is configured
+terraform import contentful_app_definition.color_picker 5Ji3ZnnIUhzDKxRhvMdOvT
@@ -0,0 +1,44 @@
+resource "contentful_app_definition" "color_picker" {
+  name = "Color picker"
+
+  location {
+    location = "entry-field"
+
+    field_type {
+      type = "Symbol"
+    }
+
+    field_type {
+      type       = "Array"
+      items_type = "Symbol"
+    }
+  }
+
+  location {
+    location = "app-config"
+  }
+
+  location {
+    location = "page"
+
+    navigation_item {
+      name = "Palettes"
+      path = "/palettes"
+    }
+  }
+
+  instance_parameter {
+    id      = "palette"
+    name    = "Palette"
+    type    = "Enum"
+    options = ["brand", "full"]
+    default = "brand"
+  }
+
+  installation_parameter {
+    id       = "apiKey"
+    name     = "API key"
+    type     = "Secret"
+    required = true
+  }
+}
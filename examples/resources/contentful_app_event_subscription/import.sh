@@ -0,0 +1,2 @@
+# Event subscriptions can be imported using <organization_id>/<app_definition_id>
+terraform import contentful_app_event_subscription.color_picker 0abc123/5Ji3ZnnIUhzDKxRhvMdOvT
@@ -0,0 +1,9 @@
+resource "contentful_app_event_subscription" "color_picker" {
+  app_definition_id = contentful_app_definition.color_picker.app_definition_id
+  target_url        = "https://color-picker.example.com/events"
+  topics            = ["Entry.publish", "Entry.unpublish"]
+
+  filters = jsonencode([
+    { in = [{ doc = "sys.environment.sys.id" }, ["master"]] },
+  ])
+}
@@ -0,0 +1,5 @@
+# App installations can be imported using <space_id>/<env_id>/<app_definition_id>
+terraform import contentful_app_installation.translation abc123/master/1UfQxNOCkHuy4PbgHvTbVq
+
+# or by app definition id alone when the provider space_id and env are configured
+terraform import contentful_app_installation.translation 1UfQxNOCkHuy4PbgHvTbVq
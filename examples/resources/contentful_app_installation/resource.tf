@@ -0,0 +1,10 @@
+resource "contentful_app_installation" "translation" {
+  space_id          = "abc123"
+  app_definition_id = "1UfQxNOCkHuy4PbgHvTbVq"
+  accept_terms      = true
+
+  parameters = jsonencode({
+    sourceLocale  = "en-US"
+    autoTranslate = false
+  })
+}
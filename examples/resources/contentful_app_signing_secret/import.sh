@@ -0,0 +1,3 @@
+# Signing secrets can be imported using <organization_id>/<app_definition_id>.
+# The secret cannot be read back, so the next apply sets it again.
+terraform import contentful_app_signing_secret.color_picker 0abc123/5Ji3ZnnIUhzDKxRhvMdOvT
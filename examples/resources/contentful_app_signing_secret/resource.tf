@@ -0,0 +1,4 @@
+resource "contentful_app_signing_secret" "color_picker" {
+  app_definition_id = contentful_app_definition.color_picker.app_definition_id
+  value             = var.signing_secret
+}
@@ -0,0 +1,7 @@
+# Content types can be imported using <space_id>/<env_id>/<content_type_id>
+terraform import contentful_contenttype.test abc123/master/test
+
+# or by content type id alone when the provider space_id and env are configured
+terraform import contentful_contenttype.test test
+
+# Imported content types are protected until the config sets protected = false
@@ -0,0 +1,2 @@
+# Publications can be imported using <space_id>/<env_id>/<content_type_id>
+terraform import contentful_contenttype_publication.test abc123/master/test
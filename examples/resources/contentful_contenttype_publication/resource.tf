@@ -0,0 +1,21 @@
+resource "contentful_contenttype" "article" {
+  space_id        = "abc123"
+  content_type_id = "article"
+  name            = "Article"
+  display_field   = "title"
+  publish         = false
+
+  field {
+    id       = "title"
+    name     = "Title"
+    type     = "Symbol"
+    required = true
+  }
+}
+
+resource "contentful_contenttype_publication" "article" {
+  space_id        = contentful_contenttype.article.space_id
+  env_id          = contentful_contenttype.article.env_id
+  content_type_id = contentful_contenttype.article.content_type_id
+  version         = contentful_contenttype.article.version
+}
@@ -0,0 +1,5 @@
+# Extensions can be imported using <space_id>/<env_id>/<extension_id>
+terraform import contentful_extension.slug_editor abc123/master/slug-editor
+
+# or by extension id alone when the provider space_id and env are configured
+terraform import contentful_extension.slug_editor slug-editor
@@ -0,0 +1,17 @@
+resource "contentful_extension" "slug_editor" {
+  space_id     = "abc123"
+  extension_id = "slug-editor"
+  name         = "Slug editor"
+  srcdoc_file  = "${path.module}/extensions/slug-editor.html"
+
+  field_type {
+    type = "Symbol"
+  }
+
+  instance_parameter {
+    id      = "prefix"
+    name    = "Prefix"
+    type    = "Symbol"
+    default = "/"
+  }
+}
@@ -0,0 +1,5 @@
+# Scheduled actions can be imported using <space_id>/<env_id>/<scheduled_action_id>
+terraform import contentful_scheduled_action.launch abc123/master/4kRqG1LoeFsrwChMr0wYkD
+
+# or by scheduled action id alone when the provider space_id and env are configured
+terraform import contentful_scheduled_action.launch 4kRqG1LoeFsrwChMr0wYkD
@@ -0,0 +1,7 @@
+resource "contentful_scheduled_action" "launch" {
+  space_id  = "abc123"
+  entity_id = "5KsDBWseXY6QegucYAoacS"
+  action    = "publish"
+  datetime  = "2030-01-01T09:00:00+01:00"
+  timezone  = "Europe/Berlin"
+}
@@ -0,0 +1,5 @@
+# Tags can be imported using <space_id>/<env_id>/<tag_id>
+terraform import contentful_tag.campaign abc123/master/campaign
+
+# or by tag id alone when the provider space_id and env are configured
+terraform import contentful_tag.campaign campaign
@@ -0,0 +1,6 @@
+resource "contentful_tag" "campaign" {
+  space_id   = "abc123"
+  tag_id     = "campaign"
+  name       = "Campaign"
+  visibility = "public"
+}
@@ -24,13 +24,16 @@ resource "contentful_contenttype" "test" {
     type      = "Symbol"
     localized = false
     required  = false
+
+    validation {
+      regexp {
+        pattern = "((([A-Za-z]{3,9}:(?:\\/\\/)?)(?:[-;:&=\\+\\$,\\w]+@)?[A-Za-z0-9.-]+|(?:www.|[-;:&=\\+\\$,\\w]+@)[A-Za-z0-9.-]+)((?:\\/[\\+~%\\/.\\w-_]*)?\\??(?:[-\\+=&;%@.\\w_]*)#?(?:[\\w]*))?)"
+      }
+      message = "URL is not valid"
+    }
+
+    # validations can also be given as JSON, e.g. for options the typed blocks do not cover
     validations = [
-      jsonencode({
-        regexp = {
-          pattern = "((([A-Za-z]{3,9}:(?:\\/\\/)?)(?:[-;:&=\\+\\$,\\w]+@)?[A-Za-z0-9.-]+|(?:www.|[-;:&=\\+\\$,\\w]+@)[A-Za-z0-9.-]+)((?:\\/[\\+~%\\/.\\w-_]*)?\\??(?:[-\\+=&;%@.\\w_]*)#?(?:[\\w]*))?)"
-        }
-        message = "URL is not valid"
-      }),
       jsonencode({
         prohibitRegexp = {
           pattern = "(\\[…\\])"
@@ -38,6 +41,7 @@ resource "contentful_contenttype" "test" {
         message = "Brackets ([...]) not allowed in URL"
       })
     ]
+
     disabled = false
     omitted  = false
   }
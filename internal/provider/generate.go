@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/the-urge-tech/terraform-provider-contentful/pkg/contentful"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var invalidNameChars = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+
+// GenerateContentTypes writes a contentful_contenttype resource together with
+// a matching import block for every content type in the given environment.
+// Each content type is written to its own file in dir, and the paths of the
+// written files are returned. managedMarker and managedDescriptionPrefix are
+// the provider settings the content types will be managed with, so the
+// marker is left out of the generated descriptions.
+func GenerateContentTypes(ctx context.Context, client *contentful.Client, spaceID string, envID string, dir string, managedMarker string, managedDescriptionPrefix string) ([]string, error) {
+	envID = client.ResolveEnv(envID)
+	client = client.Env(envID)
+
+	cts, err := client.ContentType.List(ctx, spaceID, envID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	data := &providerData{managedMarker: managedMarker, managedDescriptionPrefix: managedDescriptionPrefix}
+	files := make([]string, 0, len(cts))
+
+	for _, ct := range cts {
+		name, b, err := generateContentType(ct, spaceID, envID, data)
+		if err != nil {
+			return nil, err
+		}
+
+		path := filepath.Join(dir, fmt.Sprintf("contenttype_%s.tf", name))
+		if err := os.WriteFile(path, b, 0644); err != nil {
+			return nil, err
+		}
+
+		files = append(files, path)
+	}
+
+	return files, nil
+}
+
+// generateContentType returns the resource name and the formatted HCL of the
+// resource and import block for ct.
+func generateContentType(ct map[string]interface{}, spaceID string, envID string, data *providerData) (string, []byte, error) {
+	id := ct["sys"].(map[string]interface{})["id"].(string)
+
+	if ct["fields"] == nil {
+		ct["fields"] = []interface{}{}
+	}
+
+	err := convertFieldsForReading(ct["fields"], nil)
+	if err != nil {
+		return "", nil, fmt.Errorf("unknown error when processing fields for content type:%s : %s", id, err.Error())
+	}
+
+	annotations, taxonomy, fieldAnnotations := convertMetadataForReading(ct["metadata"])
+	setFieldAnnotationsForReading(ct["fields"], fieldAnnotations)
+
+	// imported content types are protected, see resourceContentTypeImport
+	values := map[string]interface{}{
+		"protected":       true,
+		"space_id":        spaceID,
+		"env_id":          envID,
+		"content_type_id": id,
+		"name":            ct["name"],
+		"description":     getManagedDescription(ct, data),
+		"display_field":   ct["displayField"],
+		"annotation":      annotations,
+		"taxonomy":        taxonomy,
+		"field":           ct["fields"],
+	}
+
+	name := generatedResourceName(id)
+
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+
+	block := body.AppendNewBlock("resource", []string{"contentful_contenttype", name})
+	writeGeneratedBody(block.Body(), resourceContentfulContentType().Schema, values)
+	body.AppendNewline()
+
+	importBlock := body.AppendNewBlock("import", nil)
+	importBlock.Body().SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: "contentful_contenttype"},
+		hcl.TraverseAttr{Name: name},
+	})
+	importBlock.Body().SetAttributeValue("id", cty.StringVal(fmt.Sprintf("%s/%s/%s", spaceID, envID, id)))
+
+	return name, hclwrite.Format(f.Bytes()), nil
+}
+
+func generatedResourceName(id string) string {
+	name := invalidNameChars.ReplaceAllString(id, "_")
+	if name == "" || !(name[0] == '_' || (name[0] >= 'a' && name[0] <= 'z') || (name[0] >= 'A' && name[0] <= 'Z')) {
+		name = "_" + name
+	}
+	return name
+}
+
+// writeGeneratedBody writes values into body following the shape of s. Nested
+// resources become blocks, everything else becomes an attribute. Optional
+// values left at their zero value are skipped to keep the output short.
+func writeGeneratedBody(body *hclwrite.Body, s map[string]*schema.Schema, values map[string]interface{}) {
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return generatedKeyOrder(keys[i]) < generatedKeyOrder(keys[j]) ||
+			(generatedKeyOrder(keys[i]) == generatedKeyOrder(keys[j]) && keys[i] < keys[j])
+	})
+
+	for _, k := range keys {
+		sch := s[k]
+		v, ok := values[k]
+
+		if !ok || v == nil || (sch.Computed && !sch.Optional) {
+			continue
+		}
+
+		if elem, ok := sch.Elem.(*schema.Resource); ok {
+			items, _ := v.([]interface{})
+			for _, item := range items {
+				m, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				writeGeneratedBody(body.AppendNewBlock(k, nil).Body(), elem.Schema, m)
+			}
+			continue
+		}
+
+		if !sch.Required && isGeneratedZeroValue(v) {
+			continue
+		}
+
+		body.SetAttributeValue(k, generatedValue(v))
+	}
+}
+
+func generatedKeyOrder(k string) int {
+	switch k {
+	case "id", "content_type_id":
+		return 0
+	case "name":
+		return 1
+	case "type":
+		return 2
+	}
+	return 3
+}
+
+func isGeneratedZeroValue(v interface{}) bool {
+	switch t := v.(type) {
+	case string:
+		return t == ""
+	case bool:
+		return !t
+	case int:
+		return t == 0
+	case []interface{}:
+		return len(t) == 0
+	case map[string]interface{}:
+		return len(t) == 0
+	}
+	return false
+}
+
+func generatedValue(v interface{}) cty.Value {
+	switch t := v.(type) {
+	case string:
+		return cty.StringVal(t)
+	case bool:
+		return cty.BoolVal(t)
+	case int:
+		return cty.NumberIntVal(int64(t))
+	case float64:
+		return cty.NumberFloatVal(t)
+	case []interface{}:
+		if len(t) == 0 {
+			return cty.EmptyTupleVal
+		}
+		vals := make([]cty.Value, 0, len(t))
+		for _, e := range t {
+			vals = append(vals, generatedValue(e))
+		}
+		return cty.TupleVal(vals)
+	case map[string]interface{}:
+		if len(t) == 0 {
+			return cty.EmptyObjectVal
+		}
+		vals := make(map[string]cty.Value, len(t))
+		for k, e := range t {
+			vals[k] = generatedValue(e)
+		}
+		return cty.ObjectVal(vals)
+	}
+	return cty.NullVal(cty.DynamicPseudoType)
+}
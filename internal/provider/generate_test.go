@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files in testdata")
+
+const generateContentTypeJSON = `{
+	"sys": {"id": "blogPost", "version": 7, "publishedVersion": 6},
+	"name": "Blog post",
+	"description": "[DO NOT EDIT: Managed by Terraform] Posts of the blog",
+	"displayField": "title",
+	"metadata": {
+		"annotations": {
+			"ContentType": [{"sys": {"type": "Link", "linkType": "Annotation", "id": "Contentful:AggregateRoot"}}],
+			"ContentTypeField": {
+				"title": [{"sys": {"type": "Link", "linkType": "Annotation", "id": "Contentful:GraphQLFieldResolver"}, "parameters": {"appFunctionId": "resolve"}}]
+			}
+		},
+		"taxonomy": [{"sys": {"type": "Link", "linkType": "TaxonomyConceptScheme", "id": "topics"}, "required": true}]
+	},
+	"fields": [
+		{
+			"id": "title",
+			"name": "Title",
+			"type": "Symbol",
+			"localized": true,
+			"required": true,
+			"disabled": false,
+			"omitted": false,
+			"validations": [{"size": {"min": 1, "max": 80}, "message": "too long"}, {"unique": true}],
+			"defaultValue": {"en-US": "Untitled"}
+		},
+		{
+			"id": "rating",
+			"name": "Rating",
+			"type": "Integer",
+			"localized": false,
+			"required": false,
+			"disabled": false,
+			"omitted": false,
+			"validations": [{"in": [1, 2, 3]}, {"someday": {"a": 1}}],
+			"defaultValue": {"en-US": 3}
+		},
+		{
+			"id": "authors",
+			"name": "Authors",
+			"type": "Array",
+			"localized": false,
+			"required": false,
+			"disabled": false,
+			"omitted": false,
+			"items": {"type": "Link", "linkType": "Entry", "validations": [{"linkContentType": ["author"]}]}
+		}
+	]
+}`
+
+func TestGenerateContentType(t *testing.T) {
+	var ct map[string]interface{}
+	if err := json.Unmarshal([]byte(generateContentTypeJSON), &ct); err != nil {
+		t.Fatal(err)
+	}
+
+	data := &providerData{managedMarker: managedMarkerDescription, managedDescriptionPrefix: warningMessage}
+	name, got, err := generateContentType(ct, "space", "master", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "blogPost" {
+		t.Errorf("got name %s", name)
+	}
+
+	golden := filepath.Join("testdata", "contenttype_blogPost.tf")
+	if *updateGolden {
+		if err := os.WriteFile(golden, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("generated HCL differs from %s, run go test -run TestGenerateContentType -update to see the change:\n%s", golden, got)
+	}
+}
+
+func TestGenerateContentTypeDescription(t *testing.T) {
+	cases := []struct {
+		name        string
+		data        *providerData
+		description string
+		want        string
+	}{
+		{
+			name:        "custom prefix",
+			data:        &providerData{managedMarker: managedMarkerDescription, managedDescriptionPrefix: "[terraform] "},
+			description: "[terraform] Posts",
+			want:        `description = "Posts"`,
+		},
+		{
+			name:        "tag marker",
+			data:        &providerData{managedMarker: managedMarkerTag, managedDescriptionPrefix: warningMessage},
+			description: warningMessage + "Posts",
+			want:        `description = "[DO NOT EDIT: Managed by Terraform] Posts"`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ct := map[string]interface{}{
+				"sys":          map[string]interface{}{"id": "post"},
+				"name":         "Post",
+				"description":  c.description,
+				"displayField": "title",
+			}
+
+			_, got, err := generateContentType(ct, "space", "master", c.data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !containsLine(string(got), c.want) {
+				t.Errorf("expected %s in:\n%s", c.want, got)
+			}
+		})
+	}
+}
+
+// containsLine reports whether s has a line equal to line, ignoring the
+// alignment hclwrite adds.
+func containsLine(s, line string) bool {
+	for _, l := range strings.Split(s, "\n") {
+		if strings.Join(strings.Fields(l), " ") == line {
+			return true
+		}
+	}
+	return false
+}
+
+func TestGeneratedResourceName(t *testing.T) {
+	cases := map[string]string{
+		"blogPost":  "blogPost",
+		"blog-post": "blog-post",
+		"blog.post": "blog_post",
+		"2column":   "_2column",
+		"_private":  "_private",
+		"":          "_",
+	}
+
+	for id, want := range cases {
+		if got := generatedResourceName(id); got != want {
+			t.Errorf("%q: got %q, want %q", id, got, want)
+		}
+	}
+}
+
+func TestIsGeneratedZeroValue(t *testing.T) {
+	zero := []interface{}{"", false, 0, []interface{}{}, map[string]interface{}{}}
+	for _, v := range zero {
+		if !isGeneratedZeroValue(v) {
+			t.Errorf("%#v: expected a zero value", v)
+		}
+	}
+
+	nonZero := []interface{}{"a", true, 1, 0.0, []interface{}{""}, map[string]interface{}{"a": ""}}
+	for _, v := range nonZero {
+		if isGeneratedZeroValue(v) {
+			t.Errorf("%#v: expected a non zero value", v)
+		}
+	}
+}
+
+func TestWriteGeneratedBody(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"name":     {Type: schema.TypeString, Required: true},
+		"id":       {Type: schema.TypeString, Required: true},
+		"count":    {Type: schema.TypeInt, Required: true},
+		"note":     {Type: schema.TypeString, Optional: true},
+		"computed": {Type: schema.TypeString, Computed: true},
+		"block": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {Type: schema.TypeBool, Optional: true},
+				},
+			},
+		},
+	}
+
+	f := hclwrite.NewEmptyFile()
+	writeGeneratedBody(f.Body(), s, map[string]interface{}{
+		"name":     "a",
+		"id":       "b",
+		"count":    0,
+		"note":     "",
+		"computed": "c",
+		"block":    []interface{}{map[string]interface{}{"enabled": true}},
+	})
+
+	// required values are written even when zero, ids and names come first
+	want := "id   = \"b\"\nname = \"a\"\nblock {\n  enabled = true\n}\ncount = 0\n"
+	if got := string(hclwrite.Format(f.Bytes())); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
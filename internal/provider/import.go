@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// importEnvResource imports environment scoped resources by
+// <space_id>/<env_id>/<id>, or by id alone when the provider space_id and env
+// are configured. idName names the id in the error message.
+func importEnvResource(idName string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		client := meta.(*providerData).client
+
+		var spaceID, envID, id string
+		ids := strings.Split(d.Id(), "/")
+
+		switch len(ids) {
+		case 1:
+			spaceID = client.ResolveSpace("")
+			envID = client.ResolveEnv("")
+			id = ids[0]
+		case 3:
+			spaceID = ids[0]
+			envID = ids[1]
+			id = ids[2]
+		}
+
+		if spaceID == "" || envID == "" || id == "" {
+			return nil, fmt.Errorf("invalid import id %q: expected <space_id>/<env_id>/<%s>, or <%s> when the provider space_id and env are configured", d.Id(), idName, idName)
+		}
+
+		d.SetId(fmt.Sprintf("%s/%s/%s", spaceID, envID, id))
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
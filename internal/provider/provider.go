@@ -5,11 +5,33 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/the-urge-tech/terraform-provider-contentful/pkg/contentful"
 )
 
 const warningMessage = "[DO NOT EDIT: Managed by Terraform] "
 
+const (
+	managedMarkerDescription = "description"
+	managedMarkerTag         = "tag"
+	managedMarkerNone        = "none"
+)
+
+const (
+	breakingChangeError = "error"
+	breakingChangeWarn  = "warn"
+	breakingChangeAllow = "allow"
+)
+
+// providerData is handed to every resource as its meta.
+type providerData struct {
+	client                   *contentful.Client
+	breakingChangePolicy     string
+	managedMarker            string
+	managedDescriptionPrefix string
+	managedTagID             string
+}
+
 func init() {
 	schema.DescriptionKind = schema.StringMarkdown
 }
@@ -30,15 +52,56 @@ func New(version string) func() *schema.Provider {
 					DefaultFunc: schema.EnvDefaultFunc("CONTENTFUL_ORGANIZATION_ID", nil),
 					Description: "The organization ID",
 				},
+				"space_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("CONTENTFUL_SPACE_ID", nil),
+					Description: "The default space id, used when importing resources by id alone",
+				},
 				"env": {
 					Type:        schema.TypeString,
-					Required:    true,
+					Optional:    true,
 					DefaultFunc: schema.EnvDefaultFunc("CONTENTFUL_ENVIRONMENT", nil),
-					Description: "The target environment id",
+					Description: "The default target environment id, used by resources that do not set their own environment",
+				},
+				"breaking_change_policy": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Default:          breakingChangeWarn,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{breakingChangeError, breakingChangeWarn, breakingChangeAllow}, false)),
+					Description:      "What to do when a plan changes the type, link type, items type or localized flag of a field, or makes a field required: `error` fails the plan, `warn` lists the changes in `field_changes.breaking` of the plan and warns on apply, and `allow` applies silently",
+				},
+				"managed_marker": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Default:          managedMarkerDescription,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{managedMarkerDescription, managedMarkerTag, managedMarkerNone}, false)),
+					Description:      "Where to mark content types as managed by Terraform: `description` prefixes their description with `managed_description_prefix`, `tag` adds the `managed_tag_id` tag to their metadata and `none` does not mark them",
+				},
+				"managed_description_prefix": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     warningMessage,
+					Description: "The prefix added to descriptions when `managed_marker` is `description`",
+				},
+				"managed_tag_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "managedByTerraform",
+					Description: "The id of the tag added to content types when `managed_marker` is `tag`. The tag is not created by the provider and has to exist in every environment content types are managed in, e.g. as a `contentful_tag` the content types depend on",
 				},
 			},
 			ResourcesMap: map[string]*schema.Resource{
-				"contentful_contenttype": resourceContentfulContentType(),
+				"contentful_contenttype":             resourceContentfulContentType(),
+				"contentful_contenttype_publication": resourceContentfulContentTypePublication(),
+				"contentful_tag":                     resourceContentfulTag(),
+				"contentful_app_installation":        resourceContentfulAppInstallation(),
+				"contentful_app_definition":          resourceContentfulAppDefinition(),
+				"contentful_app_bundle":              resourceContentfulAppBundle(),
+				"contentful_app_signing_secret":      resourceContentfulAppSigningSecret(),
+				"contentful_app_event_subscription":  resourceContentfulAppEventSubscription(),
+				"contentful_extension":               resourceContentfulExtension(),
+				"contentful_scheduled_action":        resourceContentfulScheduledAction(),
 			},
 		}
 
@@ -50,7 +113,13 @@ func New(version string) func() *schema.Provider {
 
 func configure(version string, p *schema.Provider) func(context.Context, *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-		c := contentful.NewClient(d.Get("cma_token").(string), d.Get("organization_id").(string), d.Get("env").(string))
-		return c, nil
+		c := contentful.NewClient(d.Get("cma_token").(string), d.Get("organization_id").(string), d.Get("space_id").(string), d.Get("env").(string))
+		return &providerData{
+			client:                   c,
+			breakingChangePolicy:     d.Get("breaking_change_policy").(string),
+			managedMarker:            d.Get("managed_marker").(string),
+			managedDescriptionPrefix: d.Get("managed_description_prefix").(string),
+			managedTagID:             d.Get("managed_tag_id").(string),
+		}, nil
 	}
 }
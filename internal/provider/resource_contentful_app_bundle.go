@@ -0,0 +1,254 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/the-urge-tech/terraform-provider-contentful/internal/utils"
+)
+
+func resourceContentfulAppBundle() *schema.Resource {
+	return &schema.Resource{
+		Description: "A bundle of an app definition, uploaded from a local zip file. Changing the file uploads a new bundle.",
+
+		CreateContext: resourceAppBundleCreate,
+		ReadContext:   resourceAppBundleRead,
+		UpdateContext: resourceAppBundleUpdate,
+		DeleteContext: resourceAppBundleDelete,
+		CustomizeDiff: resourceAppBundleCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"organization_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The organization id. Defaults to the provider `organization_id`.",
+			},
+			"app_definition_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"file": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path to the zip file with the built app",
+			},
+			"file_hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 of the uploaded file",
+			},
+			"comment": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"activate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Serves the app from this bundle. Leave `src` and `bundle_id` of the app definition unset when using it. The bundle the app is served from cannot be destroyed, so set `create_before_destroy` in its `lifecycle` to activate a new bundle before the previous one is destroyed when `file` changes",
+			},
+			"bundle_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAppBundleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("file") {
+		return d.SetNewComputed("file_hash")
+	}
+
+	hash, err := fileHash(d.Get("file").(string))
+	if err != nil {
+		return err
+	}
+
+	if d.Id() == "" {
+		return d.SetNew("file_hash", hash)
+	}
+
+	if hash != d.Get("file_hash").(string) {
+		if err := d.SetNew("file_hash", hash); err != nil {
+			return err
+		}
+		return d.ForceNew("file_hash")
+	}
+
+	return nil
+}
+
+func fileHash(path string) (string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read bundle file: %s", err.Error())
+	}
+
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func resourceAppBundleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*providerData).client
+
+	organizationID := client.ResolveOrganization(d.Get("organization_id").(string))
+	appDefinitionID := d.Get("app_definition_id").(string)
+
+	path := d.Get("file").(string)
+	hash, err := fileHash(path)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return diag.Errorf("Unknown error when opening bundle file: %s", err.Error())
+	}
+	defer f.Close()
+
+	uploadID, err := client.AppBundle.Upload(ctx, organizationID, f)
+	if err != nil {
+		return diag.Errorf("Unknown error when uploading app bundle: %s", err.Error())
+	}
+
+	res, err := client.AppBundle.Create(ctx, organizationID, appDefinitionID, uploadID, d.Get("comment").(string))
+	if err != nil {
+		return diag.Errorf("Unknown error when creating app bundle: %s", err.Error())
+	}
+
+	id := res["sys"].(map[string]interface{})["id"].(string)
+
+	d.Set("organization_id", organizationID)
+	d.Set("file_hash", hash)
+	d.Set("bundle_id", id)
+	d.SetId(fmt.Sprintf("%s/%s/%s", organizationID, appDefinitionID, id))
+
+	if d.Get("activate").(bool) {
+		if err := activateAppBundle(ctx, meta, organizationID, appDefinitionID, id); err != nil {
+			// the bundle exists, the next apply activates it
+			d.Set("activate", false)
+			return diag.Errorf("Unknown error when activating app bundle: %s", err.Error())
+		}
+	}
+
+	return diags
+}
+
+func resourceAppBundleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids := strings.Split(d.Id(), "/")
+	if len(ids) != 3 {
+		return diag.Errorf("Got invalid id: %s", d.Id())
+	}
+
+	client := meta.(*providerData).client
+
+	_, err := client.AppBundle.Read(ctx, ids[0], ids[1], ids[2])
+
+	if err != nil && strings.Contains(err.Error(), "status code 404") {
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return diag.Errorf("Unknown error when getting app bundle with id:%s : %s", d.Id(), err.Error())
+	}
+
+	// another bundle was activated outside of Terraform. A bundle that is
+	// still served with activate turned off is left as it is, since turning
+	// activate off does not deactivate it
+	if d.Get("activate").(bool) {
+		definition, err := client.AppDefinition.Read(ctx, ids[0], ids[1])
+		if err != nil {
+			return diag.Errorf("Unknown error when getting app definition of app bundle with id:%s : %s", d.Id(), err.Error())
+		}
+
+		d.Set("activate", getAppBundleID(definition) == ids[2])
+	}
+
+	d.Set("organization_id", ids[0])
+	d.Set("app_definition_id", ids[1])
+	d.Set("bundle_id", ids[2])
+
+	return diags
+}
+
+func resourceAppBundleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids := strings.Split(d.Id(), "/")
+	if len(ids) != 3 {
+		return diag.Errorf("Got invalid id: %s", d.Id())
+	}
+
+	// turning activate off leaves the app served from this bundle until
+	// another one is activated
+	if d.HasChange("activate") && d.Get("activate").(bool) {
+		if err := activateAppBundle(ctx, meta, ids[0], ids[1], ids[2]); err != nil {
+			return diag.Errorf("Unknown error when activating app bundle: %s", err.Error())
+		}
+	}
+
+	return diags
+}
+
+func resourceAppBundleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids := strings.Split(d.Id(), "/")
+	if len(ids) != 3 {
+		return diag.Errorf("Got invalid id: %s", d.Id())
+	}
+
+	client := meta.(*providerData).client
+
+	definition, err := client.AppDefinition.Read(ctx, ids[0], ids[1])
+	if err != nil && !strings.Contains(err.Error(), "status code 404") {
+		return diag.Errorf("Unknown error when getting app definition of app bundle with id:%s : %s", d.Id(), err.Error())
+	}
+
+	// replacing the bundle destroys it first unless create_before_destroy is
+	// set, which would leave the app without a bundle to serve
+	if err == nil && getAppBundleID(definition) == ids[2] {
+		return diag.Errorf("App bundle %s is the bundle app definition %s is served from and cannot be destroyed. Set create_before_destroy in the lifecycle of the bundle so a new bundle is activated before this one is destroyed, or serve the app from another bundle or src first", ids[2], ids[1])
+	}
+
+	err = client.AppBundle.Delete(ctx, ids[0], ids[1], ids[2])
+	if err != nil && !strings.Contains(err.Error(), "status code 404") {
+		return diag.Errorf("Unknown error when deleting app bundle: %s", err.Error())
+	}
+
+	d.SetId("")
+	return diags
+}
+
+// activateAppBundle serves the app of the app definition from the bundle.
+func activateAppBundle(ctx context.Context, meta interface{}, organizationID string, appDefinitionID string, id string) error {
+	client := meta.(*providerData).client
+
+	definition, err := client.AppDefinition.Read(ctx, organizationID, appDefinitionID)
+	if err != nil {
+		return err
+	}
+
+	body := utils.CopyMap(definition)
+	delete(body, "sys")
+	delete(body, "src")
+	body["bundle"] = map[string]interface{}{"sys": link("AppBundle", id)}
+
+	_, err = client.AppDefinition.Update(ctx, organizationID, appDefinitionID, getVersion(definition), body)
+	return err
+}
@@ -0,0 +1,585 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var appLocations = []string{"entry-field", "entry-sidebar", "entry-editor", "page", "dialog", "app-config", "home"}
+var appParameterTypes = []string{"Symbol", "Enum", "Number", "Boolean", "Secret"}
+
+func resourceContentfulAppDefinition() *schema.Resource {
+	return &schema.Resource{
+		Description: "An app definition of an organization. The app is either hosted at `src` or served from a bundle uploaded with `contentful_app_bundle`.",
+
+		CreateContext: resourceAppDefinitionCreate,
+		ReadContext:   resourceAppDefinitionRead,
+		UpdateContext: resourceAppDefinitionUpdate,
+		DeleteContext: resourceAppDefinitionDelete,
+		CustomizeDiff: resourceAppDefinitionCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"organization_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The organization id. Defaults to the provider `organization_id`.",
+			},
+			"app_definition_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"src": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "URL the app is hosted at. Leave it out for apps served from a bundle",
+			},
+			"bundle_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Id of the bundle the app is served from. Set by `contentful_app_bundle` with `activate` when left out",
+			},
+			"location": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Locations of the web app the app is rendered in",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"location": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(appLocations, false)),
+						},
+						"field_type": fieldTypeSchema("Field types the app can be used with. Required for the `entry-field` location"),
+						"navigation_item": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Link to the app in the main navigation. Only for the `page` location",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"path": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"instance_parameter":     appParameterSchema("Parameters set for every instance of the app, e.g. per field it is used with"),
+			"installation_parameter": appParameterSchema("Parameters set when installing the app in an environment"),
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAppDefinitionImport,
+		},
+	}
+}
+
+func fieldTypeSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(fieldTypes, false)),
+				},
+				"link_type": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(linkTypes, false)),
+				},
+				"items_type": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(itemTypes, false)),
+				},
+				"items_link_type": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(linkTypes, false)),
+				},
+			},
+		},
+	}
+}
+
+func appParameterSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"description": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"type": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(appParameterTypes, false)),
+				},
+				"required": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+				"default": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The default value. Number parameters take a number and Boolean parameters `true` or `false`",
+				},
+				"options": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The values of an Enum parameter",
+				},
+			},
+		},
+	}
+}
+
+func resourceAppDefinitionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	locations, _ := d.Get("location").([]interface{})
+	for i, iLocation := range locations {
+		if !d.NewValueKnown(fmt.Sprintf("location.%d.location", i)) {
+			continue
+		}
+
+		location, _ := iLocation.(map[string]interface{})
+		name, _ := location["location"].(string)
+		fieldTypes, _ := location["field_type"].([]interface{})
+		navigationItem, _ := location["navigation_item"].([]interface{})
+
+		if name == "entry-field" && len(fieldTypes) == 0 {
+			return fmt.Errorf("location %d: field_type is required for the entry-field location", i)
+		}
+
+		if name != "entry-field" && len(fieldTypes) > 0 {
+			return fmt.Errorf("location %d: field_type can only be set for the entry-field location, got %s", i, name)
+		}
+
+		if name != "page" && len(navigationItem) > 0 {
+			return fmt.Errorf("location %d: navigation_item can only be set for the page location, got %s", i, name)
+		}
+	}
+
+	for _, key := range []string{"instance_parameter", "installation_parameter"} {
+		parameters, _ := d.Get(key).([]interface{})
+		for i, iParameter := range parameters {
+			if !d.NewValueKnown(fmt.Sprintf("%s.%d.type", key, i)) || !d.NewValueKnown(fmt.Sprintf("%s.%d.default", key, i)) {
+				continue
+			}
+
+			parameter, _ := iParameter.(map[string]interface{})
+			if _, err := convertAppParameterDefaultForWriting(parameter); err != nil {
+				return fmt.Errorf("%s %v: %s", key, parameter["id"], err.Error())
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceAppDefinitionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*providerData).client
+
+	var organizationID, id string
+	ids := strings.Split(d.Id(), "/")
+
+	switch len(ids) {
+	case 1:
+		organizationID = client.ResolveOrganization("")
+		id = ids[0]
+	case 2:
+		organizationID = ids[0]
+		id = ids[1]
+	}
+
+	if organizationID == "" || id == "" {
+		return nil, fmt.Errorf("invalid import id %q: expected <organization_id>/<app_definition_id>, or <app_definition_id> when the provider organization_id is configured", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", organizationID, id))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAppDefinitionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*providerData).client
+
+	organizationID := client.ResolveOrganization(d.Get("organization_id").(string))
+
+	body, err := appDefinitionBody(d)
+	if err != nil {
+		return diag.Errorf("Unknown error when converting app definition: %s", err.Error())
+	}
+
+	res, err := client.AppDefinition.Create(ctx, organizationID, body)
+	if err != nil {
+		return diag.Errorf("Unknown error when creating app definition: %s", err.Error())
+	}
+
+	id := res["sys"].(map[string]interface{})["id"].(string)
+
+	d.Set("organization_id", organizationID)
+	d.Set("app_definition_id", id)
+	d.Set("version", getVersion(res))
+	d.SetId(fmt.Sprintf("%s/%s", organizationID, id))
+
+	return diags
+}
+
+func resourceAppDefinitionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids := strings.Split(d.Id(), "/")
+	if len(ids) != 2 {
+		return diag.Errorf("Got invalid id: %s", d.Id())
+	}
+	organizationID := ids[0]
+	id := ids[1]
+
+	client := meta.(*providerData).client
+
+	definition, err := client.AppDefinition.Read(ctx, organizationID, id)
+
+	if err != nil && strings.Contains(err.Error(), "status code 404") {
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return diag.Errorf("Unknown error when getting app definition with id:%s : %s", d.Id(), err.Error())
+	}
+
+	parameters, _ := definition["parameters"].(map[string]interface{})
+
+	d.Set("organization_id", organizationID)
+	d.Set("app_definition_id", id)
+	d.Set("name", definition["name"])
+	d.Set("src", definition["src"])
+	d.Set("bundle_id", getAppBundleID(definition))
+	d.Set("location", convertAppLocationsForReading(definition["locations"]))
+	d.Set("instance_parameter", convertAppParametersForReading(parameters["instance"]))
+	d.Set("installation_parameter", convertAppParametersForReading(parameters["installation"]))
+	d.Set("version", getVersion(definition))
+
+	return diags
+}
+
+func resourceAppDefinitionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids := strings.Split(d.Id(), "/")
+	if len(ids) != 2 {
+		return diag.Errorf("Got invalid id: %s", d.Id())
+	}
+	organizationID := ids[0]
+	id := ids[1]
+
+	client := meta.(*providerData).client
+
+	body, err := appDefinitionBody(d)
+	if err != nil {
+		return diag.Errorf("Unknown error when converting app definition: %s", err.Error())
+	}
+
+	res, err := client.AppDefinition.Update(ctx, organizationID, id, d.Get("version").(int), body)
+	if err != nil {
+		return diag.Errorf("Unknown error when updating app definition: %s", err.Error())
+	}
+
+	d.Set("version", getVersion(res))
+
+	return diags
+}
+
+func resourceAppDefinitionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids := strings.Split(d.Id(), "/")
+	if len(ids) != 2 {
+		return diag.Errorf("Got invalid id: %s", d.Id())
+	}
+
+	err := meta.(*providerData).client.AppDefinition.Delete(ctx, ids[0], ids[1])
+	if err != nil && !strings.Contains(err.Error(), "status code 404") {
+		return diag.Errorf("Unknown error when deleting app definition: %s", err.Error())
+	}
+
+	d.SetId("")
+	return diags
+}
+
+func appDefinitionBody(d *schema.ResourceData) (map[string]interface{}, error) {
+	body := map[string]interface{}{
+		"name":      d.Get("name").(string),
+		"locations": convertAppLocationsForWriting(d.Get("location")),
+	}
+
+	if v, ok := d.GetOk("src"); ok {
+		body["src"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("bundle_id"); ok {
+		body["bundle"] = map[string]interface{}{"sys": link("AppBundle", v.(string))}
+	}
+
+	parameters := make(map[string]interface{})
+	for key, name := range map[string]string{"instance_parameter": "instance", "installation_parameter": "installation"} {
+		p, err := convertAppParametersForWriting(d.Get(key))
+		if err != nil {
+			return nil, err
+		}
+		if len(p) > 0 {
+			parameters[name] = p
+		}
+	}
+	if len(parameters) > 0 {
+		body["parameters"] = parameters
+	}
+
+	return body, nil
+}
+
+func convertAppLocationsForWriting(locations interface{}) []interface{} {
+	l, _ := locations.([]interface{})
+	result := make([]interface{}, 0, len(l))
+
+	for _, iLocation := range l {
+		location, _ := iLocation.(map[string]interface{})
+		r := map[string]interface{}{"location": location["location"]}
+
+		if fieldTypes := convertFieldTypesForWriting(location["field_type"]); len(fieldTypes) > 0 {
+			r["fieldTypes"] = fieldTypes
+		}
+
+		if navigationItem := singleBlock(location["navigation_item"]); navigationItem != nil {
+			r["navigationItem"] = map[string]interface{}{
+				"name": navigationItem["name"],
+				"path": navigationItem["path"],
+			}
+		}
+
+		result = append(result, r)
+	}
+
+	return result
+}
+
+func convertAppLocationsForReading(locations interface{}) []interface{} {
+	l, _ := locations.([]interface{})
+	result := make([]interface{}, 0, len(l))
+
+	for _, iLocation := range l {
+		location, _ := iLocation.(map[string]interface{})
+		r := map[string]interface{}{"location": location["location"]}
+
+		r["field_type"] = convertFieldTypesForReading(location["fieldTypes"])
+
+		if navigationItem, ok := location["navigationItem"].(map[string]interface{}); ok {
+			r["navigation_item"] = []interface{}{map[string]interface{}{
+				"name": navigationItem["name"],
+				"path": navigationItem["path"],
+			}}
+		}
+
+		result = append(result, r)
+	}
+
+	return result
+}
+
+func convertFieldTypesForWriting(fieldTypes interface{}) []interface{} {
+	f, _ := fieldTypes.([]interface{})
+	result := make([]interface{}, 0, len(f))
+
+	for _, iFieldType := range f {
+		fieldType, _ := iFieldType.(map[string]interface{})
+		t := map[string]interface{}{"type": fieldType["type"]}
+		if linkType, _ := fieldType["link_type"].(string); linkType != "" {
+			t["linkType"] = linkType
+		}
+		if itemsType, _ := fieldType["items_type"].(string); itemsType != "" {
+			items := map[string]interface{}{"type": itemsType}
+			if itemsLinkType, _ := fieldType["items_link_type"].(string); itemsLinkType != "" {
+				items["linkType"] = itemsLinkType
+			}
+			t["items"] = items
+		}
+		result = append(result, t)
+	}
+
+	return result
+}
+
+func convertFieldTypesForReading(fieldTypes interface{}) []interface{} {
+	f, _ := fieldTypes.([]interface{})
+	result := make([]interface{}, 0, len(f))
+
+	for _, iFieldType := range f {
+		fieldType, _ := iFieldType.(map[string]interface{})
+		t := map[string]interface{}{
+			"type":      fieldType["type"],
+			"link_type": fieldType["linkType"],
+		}
+		if items, ok := fieldType["items"].(map[string]interface{}); ok {
+			t["items_type"] = items["type"]
+			t["items_link_type"] = items["linkType"]
+		}
+		result = append(result, t)
+	}
+
+	return result
+}
+
+func convertAppParametersForWriting(parameters interface{}) ([]interface{}, error) {
+	p, _ := parameters.([]interface{})
+	result := make([]interface{}, 0, len(p))
+
+	for _, iParameter := range p {
+		parameter, _ := iParameter.(map[string]interface{})
+		r := map[string]interface{}{
+			"id":   parameter["id"],
+			"name": parameter["name"],
+			"type": parameter["type"],
+		}
+
+		if description, _ := parameter["description"].(string); description != "" {
+			r["description"] = description
+		}
+
+		if required, _ := parameter["required"].(bool); required {
+			r["required"] = true
+		}
+
+		defaultValue, err := convertAppParameterDefaultForWriting(parameter)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %v: %s", parameter["id"], err.Error())
+		}
+		if defaultValue != nil {
+			r["default"] = defaultValue
+		}
+
+		if options := stringList(parameter["options"]); len(options) > 0 {
+			r["options"] = options
+		}
+
+		result = append(result, r)
+	}
+
+	return result, nil
+}
+
+func convertAppParameterDefaultForWriting(parameter map[string]interface{}) (interface{}, error) {
+	value, _ := parameter["default"].(string)
+	if value == "" {
+		return nil, nil
+	}
+
+	switch parameter["type"] {
+	case "Number":
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("default must be a number, got %q", value)
+		}
+		return f, nil
+	case "Boolean":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("default must be true or false, got %q", value)
+		}
+		return b, nil
+	}
+
+	return value, nil
+}
+
+func convertAppParametersForReading(parameters interface{}) []interface{} {
+	p, _ := parameters.([]interface{})
+	result := make([]interface{}, 0, len(p))
+
+	for _, iParameter := range p {
+		parameter, _ := iParameter.(map[string]interface{})
+		r := map[string]interface{}{
+			"id":          parameter["id"],
+			"name":        parameter["name"],
+			"description": parameter["description"],
+			"type":        parameter["type"],
+			"required":    parameter["required"],
+		}
+
+		switch v := parameter["default"].(type) {
+		case float64:
+			r["default"] = strconv.FormatFloat(v, 'f', -1, 64)
+		case bool:
+			r["default"] = strconv.FormatBool(v)
+		case string:
+			r["default"] = v
+		}
+
+		// Enum options are either values or single key objects of value and label
+		options, _ := parameter["options"].([]interface{})
+		values := make([]interface{}, 0, len(options))
+		for _, option := range options {
+			switch o := option.(type) {
+			case string:
+				values = append(values, o)
+			case map[string]interface{}:
+				for value := range o {
+					values = append(values, value)
+				}
+			}
+		}
+		r["options"] = values
+
+		result = append(result, r)
+	}
+
+	return result
+}
+
+// getAppBundleID returns the id of the bundle the app definition is served
+// from, or "" for apps hosted at src.
+func getAppBundleID(definition map[string]interface{}) string {
+	bundle, _ := definition["bundle"].(map[string]interface{})
+	sys, _ := bundle["sys"].(map[string]interface{})
+	id, _ := sys["id"].(string)
+	return id
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// throughAPI encodes v to JSON and back, the way it is sent to Contentful and
+// returned, so numbers come back as float64.
+func throughAPI(t *testing.T, v interface{}) interface{} {
+	t.Helper()
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var result interface{}
+	if err := json.Unmarshal(b, &result); err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
+
+func TestConvertAppLocationsRoundTrip(t *testing.T) {
+	config := map[string]interface{}{
+		"name": "Color picker",
+		"location": []interface{}{
+			map[string]interface{}{
+				"location": "entry-field",
+				"field_type": []interface{}{
+					map[string]interface{}{"type": "Symbol"},
+					map[string]interface{}{"type": "Link", "link_type": "Asset"},
+					map[string]interface{}{"type": "Array", "items_type": "Link", "items_link_type": "Entry"},
+				},
+			},
+			map[string]interface{}{
+				"location":        "page",
+				"navigation_item": []interface{}{map[string]interface{}{"name": "Colors", "path": "/colors"}},
+			},
+			map[string]interface{}{"location": "app-config"},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceContentfulAppDefinition().Schema, config)
+
+	written := throughAPI(t, convertAppLocationsForWriting(d.Get("location")))
+	want := throughAPI(t, []interface{}{
+		map[string]interface{}{
+			"location": "entry-field",
+			"fieldTypes": []interface{}{
+				map[string]interface{}{"type": "Symbol"},
+				map[string]interface{}{"type": "Link", "linkType": "Asset"},
+				map[string]interface{}{"type": "Array", "items": map[string]interface{}{"type": "Link", "linkType": "Entry"}},
+			},
+		},
+		map[string]interface{}{"location": "page", "navigationItem": map[string]interface{}{"name": "Colors", "path": "/colors"}},
+		map[string]interface{}{"location": "app-config"},
+	})
+	if !reflect.DeepEqual(written, want) {
+		t.Fatalf("got %v, want %v", written, want)
+	}
+
+	read := schema.TestResourceDataRaw(t, resourceContentfulAppDefinition().Schema, map[string]interface{}{})
+	if err := read.Set("location", convertAppLocationsForReading(written)); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(read.Get("location"), d.Get("location")) {
+		t.Errorf("round trip changed the locations: got %v, want %v", read.Get("location"), d.Get("location"))
+	}
+}
+
+func TestConvertAppParametersRoundTrip(t *testing.T) {
+	config := map[string]interface{}{
+		"name": "Color picker",
+		"instance_parameter": []interface{}{
+			map[string]interface{}{"id": "label", "name": "Label", "type": "Symbol", "default": "Color", "description": "Shown above the picker"},
+			map[string]interface{}{"id": "size", "name": "Size", "type": "Number", "default": "1.5", "required": true},
+			map[string]interface{}{"id": "alpha", "name": "Alpha", "type": "Boolean", "default": "false"},
+			map[string]interface{}{"id": "palette", "name": "Palette", "type": "Enum", "default": "warm", "options": []interface{}{"warm", "cold"}},
+			map[string]interface{}{"id": "token", "name": "Token", "type": "Secret"},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceContentfulAppDefinition().Schema, config)
+
+	p, err := convertAppParametersForWriting(d.Get("instance_parameter"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	written := throughAPI(t, p)
+	want := throughAPI(t, []interface{}{
+		map[string]interface{}{"id": "label", "name": "Label", "type": "Symbol", "default": "Color", "description": "Shown above the picker"},
+		map[string]interface{}{"id": "size", "name": "Size", "type": "Number", "default": 1.5, "required": true},
+		map[string]interface{}{"id": "alpha", "name": "Alpha", "type": "Boolean", "default": false},
+		map[string]interface{}{"id": "palette", "name": "Palette", "type": "Enum", "default": "warm", "options": []interface{}{"warm", "cold"}},
+		map[string]interface{}{"id": "token", "name": "Token", "type": "Secret"},
+	})
+	if !reflect.DeepEqual(written, want) {
+		t.Fatalf("got %v, want %v", written, want)
+	}
+
+	read := schema.TestResourceDataRaw(t, resourceContentfulAppDefinition().Schema, map[string]interface{}{})
+	if err := read.Set("instance_parameter", convertAppParametersForReading(written)); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(read.Get("instance_parameter"), d.Get("instance_parameter")) {
+		t.Errorf("round trip changed the parameters: got %v, want %v", read.Get("instance_parameter"), d.Get("instance_parameter"))
+	}
+}
+
+func TestConvertAppParametersForReadingLabeledOptions(t *testing.T) {
+	// Enum options set in the web app can carry a label
+	parameters := throughAPI(t, []interface{}{
+		map[string]interface{}{
+			"id":      "palette",
+			"name":    "Palette",
+			"type":    "Enum",
+			"options": []interface{}{map[string]interface{}{"warm": "Warm colors"}, "cold"},
+		},
+	})
+
+	got := convertAppParametersForReading(parameters)[0].(map[string]interface{})
+	if !reflect.DeepEqual(got["options"], []interface{}{"warm", "cold"}) {
+		t.Errorf("got options %v", got["options"])
+	}
+}
+
+func TestConvertAppParameterDefaultForWriting(t *testing.T) {
+	cases := []struct {
+		parameter map[string]interface{}
+		want      interface{}
+		wantErr   bool
+	}{
+		{parameter: map[string]interface{}{"type": "Number", "default": "10"}, want: 10.0},
+		{parameter: map[string]interface{}{"type": "Number", "default": "ten"}, wantErr: true},
+		{parameter: map[string]interface{}{"type": "Boolean", "default": "true"}, want: true},
+		{parameter: map[string]interface{}{"type": "Boolean", "default": "yes"}, wantErr: true},
+		{parameter: map[string]interface{}{"type": "Symbol", "default": "10"}, want: "10"},
+		{parameter: map[string]interface{}{"type": "Number", "default": ""}, want: nil},
+	}
+
+	for _, c := range cases {
+		got, err := convertAppParameterDefaultForWriting(c.parameter)
+		if c.wantErr {
+			if err == nil {
+				t.Errorf("%v: expected an error, got %v", c.parameter, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: %s", c.parameter, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%v: got %#v, want %#v", c.parameter, got, c.want)
+		}
+	}
+}
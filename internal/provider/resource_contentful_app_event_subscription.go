@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceContentfulAppEventSubscription() *schema.Resource {
+	return &schema.Resource{
+		Description: "Subscribes an app definition to events in the environments it is installed in.",
+
+		CreateContext: resourceAppEventSubscriptionCreate,
+		ReadContext:   resourceAppEventSubscriptionRead,
+		UpdateContext: resourceAppEventSubscriptionUpdate,
+		DeleteContext: resourceAppEventSubscriptionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"organization_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The organization id. Defaults to the provider `organization_id`.",
+			},
+			"app_definition_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target_url": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPS),
+				Description:      "HTTPS URL the events are sent to",
+			},
+			"topics": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Topics to subscribe to, e.g. `Entry.publish` or `AppInstallation.*`",
+			},
+			"filters": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validateJSONArray),
+				DiffSuppressFunc: jsonArrayDiff,
+				Description:      "JSON array of filters events must match, e.g. `[{\"in\": [{\"doc\": \"sys.environment.sys.id\"}, [\"master\"]]}]`",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAppDefinitionImport,
+		},
+	}
+}
+
+func resourceAppEventSubscriptionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerData).client
+
+	organizationID := client.ResolveOrganization(d.Get("organization_id").(string))
+	appDefinitionID := d.Get("app_definition_id").(string)
+
+	d.Set("organization_id", organizationID)
+
+	if diags := putAppEventSubscription(ctx, d, meta, organizationID, appDefinitionID); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", organizationID, appDefinitionID))
+
+	return nil
+}
+
+func resourceAppEventSubscriptionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids := strings.Split(d.Id(), "/")
+	if len(ids) != 2 {
+		return diag.Errorf("Got invalid id: %s", d.Id())
+	}
+
+	subscription, err := meta.(*providerData).client.AppEventSubscription.Read(ctx, ids[0], ids[1])
+
+	if err != nil && strings.Contains(err.Error(), "status code 404") {
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return diag.Errorf("Unknown error when getting app event subscription with id:%s : %s", d.Id(), err.Error())
+	}
+
+	filters := ""
+	if f, ok := subscription["filters"].([]interface{}); ok && len(f) > 0 {
+		b, err := json.Marshal(f)
+		if err != nil {
+			return diag.Errorf("Unknown error when processing filters of app event subscription:%s : %s", d.Id(), err.Error())
+		}
+		filters = string(b)
+	}
+
+	d.Set("organization_id", ids[0])
+	d.Set("app_definition_id", ids[1])
+	d.Set("target_url", subscription["targetUrl"])
+	d.Set("topics", subscription["topics"])
+	d.Set("filters", filters)
+
+	return diags
+}
+
+// jsonArrayDiff suppresses differences between two JSON arrays that parse to
+// the same value. An empty string is the same as an empty array.
+func jsonArrayDiff(k, old, new string, d *schema.ResourceData) bool {
+	return jsonValuesEqual(old, new, "[]")
+}
+
+func validateJSONArray(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if v == "" {
+		return nil, nil
+	}
+
+	var filters []interface{}
+	if err := json.Unmarshal([]byte(v), &filters); err != nil {
+		return nil, []error{fmt.Errorf("%q must be a JSON array: %s", k, err)}
+	}
+
+	return nil, nil
+}
+
+func resourceAppEventSubscriptionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ids := strings.Split(d.Id(), "/")
+	if len(ids) != 2 {
+		return diag.Errorf("Got invalid id: %s", d.Id())
+	}
+
+	return putAppEventSubscription(ctx, d, meta, ids[0], ids[1])
+}
+
+func putAppEventSubscription(ctx context.Context, d *schema.ResourceData, meta interface{}, organizationID string, appDefinitionID string) diag.Diagnostics {
+	body := map[string]interface{}{
+		"targetUrl": d.Get("target_url").(string),
+		"topics":    d.Get("topics"),
+	}
+
+	if v, ok := d.GetOk("filters"); ok {
+		var filters []interface{}
+		if err := json.Unmarshal([]byte(v.(string)), &filters); err != nil {
+			return diag.Errorf("filters must be a JSON array: %s", err.Error())
+		}
+		body["filters"] = filters
+	}
+
+	_, err := meta.(*providerData).client.AppEventSubscription.Put(ctx, organizationID, appDefinitionID, body)
+	if err != nil {
+		return diag.Errorf("Unknown error when updating app event subscription: %s", err.Error())
+	}
+
+	return nil
+}
+
+func resourceAppEventSubscriptionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids := strings.Split(d.Id(), "/")
+	if len(ids) != 2 {
+		return diag.Errorf("Got invalid id: %s", d.Id())
+	}
+
+	err := meta.(*providerData).client.AppEventSubscription.Delete(ctx, ids[0], ids[1])
+	if err != nil && !strings.Contains(err.Error(), "status code 404") {
+		return diag.Errorf("Unknown error when deleting app event subscription: %s", err.Error())
+	}
+
+	d.SetId("")
+	return diags
+}
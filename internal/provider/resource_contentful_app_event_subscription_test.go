@@ -0,0 +1,37 @@
+package provider
+
+import "testing"
+
+func TestJSONArrayDiff(t *testing.T) {
+	cases := []struct {
+		old  string
+		new  string
+		want bool
+	}{
+		{old: "", new: "[]", want: true},
+		{old: "[]", new: "", want: true},
+		{old: `[{"in":[1]}]`, new: `[ {"in": [1]} ]`, want: true},
+		{old: "", new: `[{"in":[1]}]`, want: false},
+		{old: "", new: "{}", want: false},
+	}
+
+	for _, c := range cases {
+		if got := jsonArrayDiff("filters", c.old, c.new, nil); got != c.want {
+			t.Errorf("%q -> %q: got %v, want %v", c.old, c.new, got, c.want)
+		}
+	}
+}
+
+func TestValidateJSONArray(t *testing.T) {
+	for _, v := range []string{"", "[]", `[{"in":[{"doc":"sys.id"},["a"]]}]`} {
+		if _, errs := validateJSONArray(v, "filters"); len(errs) != 0 {
+			t.Errorf("%q: unexpected errors %v", v, errs)
+		}
+	}
+
+	for _, v := range []string{"{}", `"a"`, "[", "1"} {
+		if _, errs := validateJSONArray(v, "filters"); len(errs) == 0 {
+			t.Errorf("%q: expected an error", v)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceContentfulAppInstallation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Installs an app in an environment. Destroying it uninstalls the app.",
+
+		CreateContext: resourceAppInstallationCreate,
+		ReadContext:   resourceAppInstallationRead,
+		UpdateContext: resourceAppInstallationUpdate,
+		DeleteContext: resourceAppInstallationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"env_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The environment id. Defaults to the provider `env`.",
+			},
+			"app_definition_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"parameters": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsJSON),
+				DiffSuppressFunc: jsonDiff,
+				Description:      "JSON object with the installation parameters of the app. Only differences in the parsed JSON show up in plans",
+			},
+			"accept_terms": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Accepts the end user license agreement, marketplace terms of service and privacy policy, which marketplace apps require to be installed",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importEnvResource("app_definition_id"),
+		},
+	}
+}
+
+// jsonDiff suppresses differences between two JSON documents that parse to
+// the same value. An empty string is the same as an empty object.
+func jsonDiff(k, old, new string, d *schema.ResourceData) bool {
+	return jsonValuesEqual(old, new, "{}")
+}
+
+// jsonValuesEqual reports whether two JSON documents parse to the same value,
+// reading an empty string as empty.
+func jsonValuesEqual(old, new, empty string) bool {
+	parse := func(s string) (interface{}, bool) {
+		if s == "" {
+			s = empty
+		}
+		var v interface{}
+		return v, json.Unmarshal([]byte(s), &v) == nil
+	}
+
+	o, ok := parse(old)
+	if !ok {
+		return false
+	}
+
+	n, ok := parse(new)
+	if !ok {
+		return false
+	}
+
+	return reflect.DeepEqual(o, n)
+}
+
+func resourceAppInstallationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerData).client
+
+	spaceID := d.Get("space_id").(string)
+	envID := client.ResolveEnv(d.Get("env_id").(string))
+	id := d.Get("app_definition_id").(string)
+
+	if envID == "" {
+		return diag.Errorf("env_id must be set when the provider env is not configured")
+	}
+
+	d.Set("env_id", envID)
+
+	if diags := putAppInstallation(ctx, d, meta, spaceID, envID, id); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", spaceID, envID, id))
+
+	return nil
+}
+
+func resourceAppInstallationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids := strings.Split(d.Id(), "/")
+	if len(ids) != 3 {
+		return diag.Errorf("Got invalid id: %s", d.Id())
+	}
+	spaceID := ids[0]
+	envID := ids[1]
+	id := ids[2]
+
+	client := meta.(*providerData).client.Env(envID)
+
+	installation, err := client.AppInstallation.Read(ctx, spaceID, envID, id)
+
+	if err != nil && strings.Contains(err.Error(), "status code 404") {
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return diag.Errorf("Unknown error when getting app installation with id:%s : %s", d.Id(), err.Error())
+	}
+
+	parameters := ""
+	if installation["parameters"] != nil {
+		b, err := json.Marshal(installation["parameters"])
+		if err != nil {
+			return diag.Errorf("Unknown error when processing parameters of app installation:%s : %s", d.Id(), err.Error())
+		}
+		parameters = string(b)
+	}
+
+	d.Set("space_id", spaceID)
+	d.Set("env_id", envID)
+	d.Set("app_definition_id", id)
+	d.Set("parameters", parameters)
+
+	return diags
+}
+
+func resourceAppInstallationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ids := strings.Split(d.Id(), "/")
+	if len(ids) != 3 {
+		return diag.Errorf("Got invalid id: %s", d.Id())
+	}
+
+	return putAppInstallation(ctx, d, meta, ids[0], ids[1], ids[2])
+}
+
+func putAppInstallation(ctx context.Context, d *schema.ResourceData, meta interface{}, spaceID string, envID string, id string) diag.Diagnostics {
+	client := meta.(*providerData).client.Env(envID)
+
+	body := make(map[string]interface{})
+	if v, ok := d.GetOk("parameters"); ok {
+		parameters := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(v.(string)), &parameters); err != nil {
+			return diag.Errorf("parameters must be a JSON object: %s", err.Error())
+		}
+		body["parameters"] = parameters
+	}
+
+	_, err := client.AppInstallation.Put(ctx, spaceID, envID, id, body, d.Get("accept_terms").(bool))
+	if err != nil {
+		return diag.Errorf("Unknown error when installing app: %s", err.Error())
+	}
+
+	return nil
+}
+
+func resourceAppInstallationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids := strings.Split(d.Id(), "/")
+	if len(ids) != 3 {
+		return diag.Errorf("Got invalid id: %s", d.Id())
+	}
+	spaceID := ids[0]
+	envID := ids[1]
+	id := ids[2]
+
+	client := meta.(*providerData).client.Env(envID)
+
+	err := client.AppInstallation.Delete(ctx, spaceID, envID, id)
+	if err != nil && !strings.Contains(err.Error(), "status code 404") {
+		return diag.Errorf("Unknown error when uninstalling app: %s", err.Error())
+	}
+
+	d.SetId("")
+	return diags
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var signingSecretPattern = regexp.MustCompile(`^[0-9a-zA-Z]{64}$`)
+
+func resourceContentfulAppSigningSecret() *schema.Resource {
+	return &schema.Resource{
+		Description: "The secret requests of an app definition are signed with. Contentful only returns the last characters of the secret, a secret changed outside of Terraform is set again on the next apply.",
+
+		CreateContext: resourceAppSigningSecretCreate,
+		ReadContext:   resourceAppSigningSecretRead,
+		UpdateContext: resourceAppSigningSecretUpdate,
+		DeleteContext: resourceAppSigningSecretDelete,
+
+		Schema: map[string]*schema.Schema{
+			"organization_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The organization id. Defaults to the provider `organization_id`.",
+			},
+			"app_definition_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"value": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Sensitive:        true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(signingSecretPattern, "must be 64 alphanumeric characters")),
+			},
+			"redacted_value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The last characters of the secret, as returned by Contentful",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAppDefinitionImport,
+		},
+	}
+}
+
+func resourceAppSigningSecretCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerData).client
+
+	organizationID := client.ResolveOrganization(d.Get("organization_id").(string))
+	appDefinitionID := d.Get("app_definition_id").(string)
+
+	d.Set("organization_id", organizationID)
+
+	if diags := putAppSigningSecret(ctx, d, meta, organizationID, appDefinitionID); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", organizationID, appDefinitionID))
+
+	return nil
+}
+
+func resourceAppSigningSecretRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids := strings.Split(d.Id(), "/")
+	if len(ids) != 2 {
+		return diag.Errorf("Got invalid id: %s", d.Id())
+	}
+
+	secret, err := meta.(*providerData).client.AppSigningSecret.Read(ctx, ids[0], ids[1])
+
+	if err != nil && strings.Contains(err.Error(), "status code 404") {
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return diag.Errorf("Unknown error when getting app signing secret with id:%s : %s", d.Id(), err.Error())
+	}
+
+	redactedValue, _ := secret["redactedValue"].(string)
+
+	// the secret cannot be read back, a different redacted value means it was
+	// changed outside of Terraform
+	if !strings.HasSuffix(d.Get("value").(string), redactedValue) {
+		d.Set("value", "")
+	}
+
+	d.Set("organization_id", ids[0])
+	d.Set("app_definition_id", ids[1])
+	d.Set("redacted_value", redactedValue)
+
+	return diags
+}
+
+func resourceAppSigningSecretUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ids := strings.Split(d.Id(), "/")
+	if len(ids) != 2 {
+		return diag.Errorf("Got invalid id: %s", d.Id())
+	}
+
+	return putAppSigningSecret(ctx, d, meta, ids[0], ids[1])
+}
+
+func putAppSigningSecret(ctx context.Context, d *schema.ResourceData, meta interface{}, organizationID string, appDefinitionID string) diag.Diagnostics {
+	body := map[string]interface{}{
+		"value": d.Get("value").(string),
+	}
+
+	res, err := meta.(*providerData).client.AppSigningSecret.Put(ctx, organizationID, appDefinitionID, body)
+	if err != nil {
+		return diag.Errorf("Unknown error when updating app signing secret: %s", err.Error())
+	}
+
+	d.Set("redacted_value", res["redactedValue"])
+
+	return nil
+}
+
+func resourceAppSigningSecretDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids := strings.Split(d.Id(), "/")
+	if len(ids) != 2 {
+		return diag.Errorf("Got invalid id: %s", d.Id())
+	}
+
+	err := meta.(*providerData).client.AppSigningSecret.Delete(ctx, ids[0], ids[1])
+	if err != nil && !strings.Contains(err.Error(), "status code 404") {
+		return diag.Errorf("Unknown error when deleting app signing secret: %s", err.Error())
+	}
+
+	d.SetId("")
+	return diags
+}
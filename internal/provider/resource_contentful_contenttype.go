@@ -3,16 +3,23 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"reflect"
+	"log"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/the-urge-tech/terraform-provider-contentful/internal/utils"
 	"github.com/the-urge-tech/terraform-provider-contentful/pkg/contentful"
 )
 
+var fieldTypes = []string{"Symbol", "Text", "RichText", "Integer", "Number", "Date", "Location", "Boolean", "Object", "Link", "Array", "ResourceLink"}
+var itemTypes = []string{"Symbol", "Link", "ResourceLink"}
+var linkTypes = []string{"Entry", "Asset"}
+
 func resourceContentfulContentType() *schema.Resource {
 	return &schema.Resource{
 		// This description is used by the documentation generator and the language server.
@@ -22,176 +29,555 @@ func resourceContentfulContentType() *schema.Resource {
 		ReadContext:   resourceContentTypeRead,
 		UpdateContext: resourceContentTypeUpdate,
 		DeleteContext: resourceContentTypeDelete,
-
-		Schema: map[string]*schema.Schema{
-			"protected": {
-				Type:     schema.TypeBool,
-				Optional: true,
-			},
-			"space_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
-			"version": {
-				Type:     schema.TypeInt,
-				Computed: true,
+		CustomizeDiff: resourceContentTypeCustomizeDiff,
+
+		SchemaVersion: 3,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceContentTypeV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceContentTypeStateUpgradeV0,
 			},
-			"name": {
-				Type:     schema.TypeString,
-				Required: true,
+			{
+				Version: 1,
+				Type:    resourceContentTypeV1().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceContentTypeStateUpgradeV1,
 			},
-			"description": {
-				Type:     schema.TypeString,
-				Optional: true,
+			{
+				Version: 2,
+				Type:    resourceContentTypeV2().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceContentTypeStateUpgradeV2,
 			},
-			"display_field": {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"content_type_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
-			"env_id": {
-				Type:     schema.TypeString,
-				Default:  "",
-				Optional: true,
-				ForceNew: true,
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					if new == "" {
-						return true
-					}
-					return old == new
-				},
-			},
-			"field": {
-				Type:     schema.TypeList,
-				Required: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"id": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"type": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"link_type": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"default_value": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"items": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"type": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"link_type": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"validations": {
-										Type:             schema.TypeList,
-										Optional:         true,
-										Elem:             &schema.Schema{Type: schema.TypeString},
-										DiffSuppressFunc: validationDiff,
-									},
+		},
+
+		Schema: resourceContentTypeSchema(),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceContentTypeImport,
+		},
+	}
+}
+
+func resourceContentTypeSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"protected": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Fails plans that remove fields or replace the content type, and prevents destroying it",
+		},
+		"space_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"publish": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Whether changes are published. When false, changes are only saved as a draft, to be published with `contentful_contenttype_publication` or from the web app. Fields cannot be deleted while this is false",
+		},
+		"version": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"field_changes": fieldChangesSchema(),
+		"published_version": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The version of the content type that is currently published, 0 when it was never published",
+		},
+		"has_unpublished_changes": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the content type has draft changes that are not published. The content type is published again on the next apply when this and `publish` are true",
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"display_field": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"annotation": annotationSchema("Annotations of the content type"),
+		"taxonomy":   taxonomySchema(),
+		"content_type_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"env_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "The environment id. Takes precedence over the provider `env`, which is used when this is not set and is resolved once at creation and kept in state afterwards.",
+		},
+		"field": {
+			Type:     schema.TypeList,
+			Required: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"previous_id": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The id this field had before. Setting it renames the field in place, keeping its content, instead of removing the old field and adding a new one",
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"type": {
+						Type:             schema.TypeString,
+						Required:         true,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(fieldTypes, false)),
+					},
+					"link_type": {
+						Type:             schema.TypeString,
+						Optional:         true,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(linkTypes, false)),
+					},
+					"default_value": defaultValueSchema(),
+					"items": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"type": {
+									Type:             schema.TypeString,
+									Required:         true,
+									ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(itemTypes, false)),
+								},
+								"link_type": {
+									Type:             schema.TypeString,
+									Optional:         true,
+									ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(linkTypes, false)),
+								},
+								"validation": validationSchema(),
+								"validations": {
+									Type:             schema.TypeList,
+									Optional:         true,
+									Elem:             &schema.Schema{Type: schema.TypeString, StateFunc: validationStateFunc},
+									DiffSuppressFunc: validationDiff,
+									Description:      "Validations as JSON strings, for anything the typed `validation` blocks cannot express",
 								},
 							},
 						},
-						"required": {
-							Type:     schema.TypeBool,
-							Optional: true,
-							Default:  false,
-						},
-						"localized": {
-							Type:     schema.TypeBool,
-							Optional: true,
-							Default:  false,
-						},
-						"disabled": {
-							Type:     schema.TypeBool,
-							Optional: true,
-							Default:  false,
-						},
-						"omitted": {
-							Type:     schema.TypeBool,
-							Optional: true,
-							Default:  false,
-						},
-						"validations": {
-							Type:             schema.TypeList,
-							Optional:         true,
-							Elem:             &schema.Schema{Type: schema.TypeString},
-							DiffSuppressFunc: validationDiff,
-						},
+					},
+					"required": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+					"localized": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+					"disabled": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+					"omitted": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+					"annotation": annotationSchema("Annotations of the field"),
+					"appearance": appearanceSchema(),
+					"rich_text":  richTextSchema(),
+					"validation": validationSchema(),
+					"validations": {
+						Type:             schema.TypeList,
+						Optional:         true,
+						Elem:             &schema.Schema{Type: schema.TypeString, StateFunc: validationStateFunc},
+						DiffSuppressFunc: validationDiff,
+						Description:      "Validations as JSON strings, for anything the typed `validation` blocks cannot express",
 					},
 				},
 			},
 		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
 	}
 }
 
+// validationDiff compares validation lists as sets of canonical JSON, so
+// neither the order of the validations nor the order of values inside them
+// causes a diff.
 func validationDiff(k, old, new string, d *schema.ResourceData) bool {
-	oldMap := make(map[string]interface{})
-	newMap := make(map[string]interface{})
+	listKey := k[:strings.LastIndex(k, ".")]
+	o, n := d.GetChange(listKey)
 
-	err := json.Unmarshal([]byte(old), &oldMap)
+	if validationSetsEqual(o, n) {
+		return true
+	}
 
-	if err != nil {
+	if strings.HasSuffix(k, ".#") {
 		return false
 	}
 
-	err = json.Unmarshal([]byte(new), &newMap)
+	oldJSON, err := canonicalValidationJSON(old)
+	if err != nil {
+		return false
+	}
 
+	newJSON, err := canonicalValidationJSON(new)
 	if err != nil {
 		return false
 	}
 
-	return reflect.DeepEqual(oldMap, newMap)
+	return oldJSON == newJSON
+}
+
+func resourceContentTypeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	client := meta.(*providerData).client
+
+	// env_id falls back to the provider env when it is left out of the config
+	if d.Id() == "" && d.GetRawConfig().GetAttr("env_id").IsNull() {
+		envID := client.ResolveEnv("")
+		if envID == "" {
+			return fmt.Errorf("env_id must be set on %s when the provider env is not configured", d.Get("content_type_id"))
+		}
+		if err := d.SetNew("env_id", envID); err != nil {
+			return err
+		}
+	}
+
+	publish := d.Get("publish").(bool)
+
+	// drafts left unpublished outside of Terraform are published again
+	if d.Id() != "" && publish && d.Get("has_unpublished_changes").(bool) {
+		if err := d.SetNew("has_unpublished_changes", false); err != nil {
+			return err
+		}
+	}
+
+	// every update saves a new version, so resources depending on version
+	// see the change in the same plan
+	for _, key := range []string{"name", "description", "display_field", "field", "publish", "has_unpublished_changes"} {
+		if d.Id() == "" || !d.HasChange(key) {
+			continue
+		}
+		if err := d.SetNewComputed("version"); err != nil {
+			return err
+		}
+		if publish {
+			if err := d.SetNewComputed("published_version"); err != nil {
+				return err
+			}
+		}
+		break
+	}
+
+	if err := validateDraftFieldDeletions(d); err != nil {
+		return err
+	}
+
+	if err := validateProtected(d); err != nil {
+		return err
+	}
+
+	if err := validateAppearance(d); err != nil {
+		return err
+	}
+
+	if err := validateFieldRenames(d); err != nil {
+		return err
+	}
+
+	if err := validateFieldTypes(d); err != nil {
+		return err
+	}
+
+	if err := validateTaxonomy(d); err != nil {
+		return err
+	}
+
+	if err := validateBreakingChanges(d, meta.(*providerData).breakingChangePolicy); err != nil {
+		return err
+	}
+
+	return setFieldChanges(d, meta.(*providerData).breakingChangePolicy)
+}
+
+// validateDraftFieldDeletions fails the plan when fields are deleted while
+// publish is false. Contentful only deletes fields that were published as
+// omitted.
+func validateDraftFieldDeletions(d *schema.ResourceDiff) error {
+	if d.Id() == "" || d.Get("publish").(bool) || !d.HasChange("field") {
+		return nil
+	}
+
+	o, n := d.GetChange("field")
+	for i := range n.([]interface{}) {
+		if !d.NewValueKnown(fmt.Sprintf("field.%d.id", i)) {
+			return nil
+		}
+	}
+
+	deletedIDs := getDeletedFieldIDs(o.([]interface{}), n.([]interface{}))
+	if len(deletedIDs) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(deletedIDs))
+	for id := range deletedIDs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	return fmt.Errorf("publish is set to false and fields %v of content type %s would be deleted, which requires publishing them as omitted first. Omit the fields with publish set to true, or set publish to true to delete them", ids, d.Id())
+}
+
+// validateProtected fails the plan when a protected content type would lose
+// fields or be replaced.
+func validateProtected(d *schema.ResourceDiff) error {
+	if d.Id() == "" || !d.Get("protected").(bool) {
+		return nil
+	}
+
+	// every ForceNew attribute replaces the content type
+	forceNew := make([]string, 0)
+	for key, s := range resourceContentTypeSchema() {
+		if s.ForceNew {
+			forceNew = append(forceNew, key)
+		}
+	}
+	sort.Strings(forceNew)
+
+	for _, key := range forceNew {
+		if d.HasChange(key) {
+			o, n := d.GetChange(key)
+			return fmt.Errorf("protected is set to true and %s changes from %q to %q, which would replace content type %s", key, o, n, d.Id())
+		}
+	}
+
+	if !d.HasChange("field") {
+		return nil
+	}
+
+	o, n := d.GetChange("field")
+	for i := range n.([]interface{}) {
+		if !d.NewValueKnown(fmt.Sprintf("field.%d.id", i)) {
+			return nil
+		}
+	}
+
+	deletedIDs := getDeletedFieldIDs(o.([]interface{}), n.([]interface{}))
+	if len(deletedIDs) == 0 {
+		return nil
+	}
+
+	removed := make([]string, 0, len(deletedIDs))
+	for fieldID := range deletedIDs {
+		removed = append(removed, fieldID)
+	}
+	sort.Strings(removed)
+
+	return fmt.Errorf("protected is set to true and these field(s) would be removed from content type %s: %s", d.Id(), strings.Join(removed, ", "))
+}
+
+// validateFieldRenames checks that no field is renamed from an id another
+// field still uses.
+func validateFieldRenames(d *schema.ResourceDiff) error {
+	fields, _ := d.Get("field").([]interface{})
+
+	ids := make(map[string]bool)
+	for _, iField := range fields {
+		if field, ok := iField.(map[string]interface{}); ok {
+			ids[field["id"].(string)] = true
+		}
+	}
+
+	for _, iField := range fields {
+		field, ok := iField.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		previousID, _ := field["previous_id"].(string)
+		if previousID != "" && previousID != field["id"] && ids[previousID] {
+			return fmt.Errorf("field %s: previous_id %s is still used by another field", field["id"], previousID)
+		}
+	}
+
+	return nil
+}
+
+// validateValidationKinds checks that no typed validation block sets more than
+// one kind of validation.
+func validateValidationKinds(id string, validations interface{}) error {
+	v, _ := validations.([]interface{})
+	for _, iValidation := range v {
+		validation, ok := iValidation.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if kinds := countValidationKinds(validation); len(kinds) > 1 {
+			return fmt.Errorf("field %s: a validation block must set exactly one kind of validation, got %v", id, kinds)
+		}
+	}
+	return nil
+}
+
+// validateFieldTypes checks that link_type is only set on Link fields, items
+// only on Array fields and that default values match the field type. Fields
+// whose type is not known yet are skipped.
+func validateFieldTypes(d *schema.ResourceDiff) error {
+	fields, _ := d.Get("field").([]interface{})
+
+	for i, f := range fields {
+		field, ok := f.(map[string]interface{})
+		if !ok || !d.NewValueKnown(fmt.Sprintf("field.%d.type", i)) {
+			continue
+		}
+
+		id := field["id"].(string)
+		fieldType := field["type"].(string)
+		linkType, _ := field["link_type"].(string)
+		items, _ := field["items"].([]interface{})
+
+		if err := validateValidationKinds(id, field["validation"]); err != nil {
+			return err
+		}
+
+		defaultValues, _ := field["default_value"].([]interface{})
+		for j, iValue := range defaultValues {
+			value, ok := iValue.(map[string]interface{})
+			if !ok || !d.NewValueKnown(fmt.Sprintf("field.%d.default_value.%d.value", i, j)) {
+				continue
+			}
+
+			if _, err := convertDefaultValueForWriting(value["value"].(string), fieldType); err != nil {
+				return fmt.Errorf("field %s: default_value for locale %s: %s", id, value["locale"], err.Error())
+			}
+		}
+
+		if fieldType == "Link" && linkType == "" && d.NewValueKnown(fmt.Sprintf("field.%d.link_type", i)) {
+			return fmt.Errorf("field %s: link_type is required when type is Link", id)
+		}
+
+		if fieldType != "Link" && linkType != "" {
+			return fmt.Errorf("field %s: link_type can only be set when type is Link, got type %s", id, fieldType)
+		}
+
+		if fieldType == "Array" && len(items) == 0 {
+			return fmt.Errorf("field %s: items is required when type is Array", id)
+		}
+
+		if richText, _ := field["rich_text"].([]interface{}); fieldType != "RichText" && len(richText) > 0 {
+			return fmt.Errorf("field %s: rich_text can only be set when type is RichText, got type %s", id, fieldType)
+		}
+
+		if fieldType != "Array" && len(items) > 0 {
+			return fmt.Errorf("field %s: items can only be set when type is Array, got type %s", id, fieldType)
+		}
+
+		if len(items) == 0 || items[0] == nil || !d.NewValueKnown(fmt.Sprintf("field.%d.items.0.type", i)) {
+			continue
+		}
+
+		item := items[0].(map[string]interface{})
+		itemType := item["type"].(string)
+		itemLinkType, _ := item["link_type"].(string)
+
+		if err := validateValidationKinds(id+".items", item["validation"]); err != nil {
+			return err
+		}
+
+		if itemType == "Link" && itemLinkType == "" && d.NewValueKnown(fmt.Sprintf("field.%d.items.0.link_type", i)) {
+			return fmt.Errorf("field %s: items.link_type is required when items.type is Link", id)
+		}
+
+		if itemType != "Link" && itemLinkType != "" {
+			return fmt.Errorf("field %s: items.link_type can only be set when items.type is Link, got type %s", id, itemType)
+		}
+	}
+
+	return nil
+}
+
+func resourceContentTypeImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*providerData).client
+
+	var spaceID, envID, id string
+	ids := strings.Split(d.Id(), "/")
+
+	switch len(ids) {
+	case 1:
+		spaceID = client.ResolveSpace("")
+		envID = client.ResolveEnv("")
+		id = ids[0]
+	case 3:
+		spaceID = ids[0]
+		envID = ids[1]
+		id = ids[2]
+	}
+
+	if spaceID == "" || envID == "" || id == "" {
+		return nil, fmt.Errorf("invalid import id %q: expected <space_id>/<env_id>/<content_type_id>, or <content_type_id> when the provider space_id and env are configured", d.Id())
+	}
+
+	_, err := client.Env(envID).ContentType.Read(ctx, spaceID, envID, id)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find content type %s in space %s and environment %s: %s", id, spaceID, envID, err.Error())
+	}
+
+	// protected is not stored remotely. Imported content types usually hold
+	// content already, so they stay protected until the config says otherwise
+	d.Set("protected", true)
+	d.Set("publish", true)
+	d.Set("space_id", spaceID)
+	d.Set("env_id", envID)
+	d.Set("content_type_id", id)
+	d.SetId(fmt.Sprintf("%s/%s/%s", spaceID, envID, id))
+
+	return []*schema.ResourceData{d}, nil
 }
 
 func resourceContentTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := meta.(*contentful.Client)
+	client := meta.(*providerData).client
 
 	spaceID := d.Get("space_id").(string)
-	envID := d.Get("env_id").(string)
+	envID := client.ResolveEnv(d.Get("env_id").(string))
 	id := d.Get("content_type_id").(string)
 
+	if envID == "" {
+		return diag.Errorf("env_id must be set when the provider env is not configured")
+	}
+
+	client = client.Env(envID)
+
+	if diags := checkManagedTag(ctx, client, meta.(*providerData), spaceID, envID); diags.HasError() {
+		return diags
+	}
+
 	body := make(map[string]interface{})
 
 	if v, ok := d.GetOk("name"); ok {
 		body["name"] = v.(string)
 	}
 
-	body["description"] = warningMessage
-	if v, ok := d.GetOk("description"); ok {
-		body["description"] = warningMessage + v.(string)
-	}
+	setManagedDescription(body, d, meta.(*providerData))
 
 	if v, ok := d.GetOk("display_field"); ok {
 		body["displayField"] = v.(string)
 	}
 
+	if err := convertMetadataForWriting(body, d.Get("annotation"), d.Get("taxonomy"), d.Get("field"), nil, nil); err != nil {
+		return diag.Errorf("Unknown error when converting metadata: %s", err.Error())
+	}
+
 	fields, err := convertFieldsForWriting(d.Get("field"))
 
 	if err != nil {
@@ -205,20 +591,31 @@ func resourceContentTypeCreate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("Unknown error when performing upsert: %s", err.Error())
 	}
 
-	res, err = client.ContentType.Activate(ctx, spaceID, envID, id, getVersion(res))
-	if err != nil {
-		return diag.Errorf("Unknown error when activating content type: %s", err.Error())
+	if d.Get("publish").(bool) {
+		res, err = client.ContentType.Activate(ctx, spaceID, envID, id, getVersion(res))
+		if err != nil {
+			return diag.Errorf("Unknown error when activating content type: %s", err.Error())
+		}
 	}
 
+	d.Set("env_id", envID)
 	d.Set("version", getVersion(res))
+	d.Set("published_version", getPublishedVersion(res))
+	d.Set("has_unpublished_changes", !isPublished(res))
 	d.SetId(fmt.Sprintf("%s/%s/%s", spaceID, envID, id))
 
+	if getPublishedVersion(res) > 0 {
+		err = writeAppearance(ctx, client, spaceID, envID, id, d.Get("field"))
+		if err != nil {
+			return diag.Errorf("Unknown error when updating editor interface: %s", err.Error())
+		}
+	}
+
 	return diags
 }
 
 func resourceContentTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := meta.(*contentful.Client)
 
 	ids := strings.Split(d.Id(), "/")
 	if len(ids) != 3 {
@@ -228,6 +625,8 @@ func resourceContentTypeRead(ctx context.Context, d *schema.ResourceData, meta i
 	envID := ids[1]
 	id := ids[2]
 
+	client := meta.(*providerData).client.Env(envID)
+
 	ct, err := client.ContentType.Read(ctx, spaceID, envID, id)
 
 	if err != nil && strings.Contains(err.Error(), "status code 404") {
@@ -239,27 +638,59 @@ func resourceContentTypeRead(ctx context.Context, d *schema.ResourceData, meta i
 		return diag.Errorf("Unknown error when getting content type with id:%s : %s", d.Id(), err.Error())
 	}
 
-	err = convertFieldsForReading(ct["fields"])
+	version := getVersion(ct)
+	publishedVersion := getPublishedVersion(ct)
+	hasUnpublishedChanges := !isPublished(ct)
+
+	// when the draft differs from what is live, compare the config with the
+	// published content type instead, so the plan shows what will go live.
+	// Drafts are what is managed when publishing is turned off.
+	if d.Get("publish").(bool) && hasUnpublishedChanges && publishedVersion > 0 {
+		published, err := client.ContentType.ReadPublished(ctx, spaceID, envID, id)
+
+		// unpublished since it was read, the draft is all there is
+		if err != nil && !errors.Is(err, contentful.ErrNotPublished) {
+			return diag.Errorf("Unknown error when getting published content type with id:%s : %s", d.Id(), err.Error())
+		}
+
+		if err == nil {
+			ct = published
+		}
+	}
+
+	err = convertFieldsForReading(ct["fields"], d.Get("field"))
 
 	if err != nil {
 		return diag.Errorf("Unknown error when processing fields for content type:%s : %s", d.Id(), err.Error())
 	}
 
+	annotations, taxonomy, fieldAnnotations := convertMetadataForReading(ct["metadata"])
+	setFieldAnnotationsForReading(ct["fields"], fieldAnnotations)
+
+	err = readAppearance(ctx, client, spaceID, envID, id, ct["fields"], d.Get("field"))
+	if err != nil {
+		return diag.Errorf("Unknown error when getting editor interface of content type:%s : %s", d.Id(), err.Error())
+	}
+
 	d.Set("content_type_id", id)
 	d.Set("env_id", envID)
 	d.Set("space_id", spaceID)
-	d.Set("version", getVersion(ct))
+	d.Set("version", version)
+	d.Set("published_version", publishedVersion)
+	d.Set("has_unpublished_changes", hasUnpublishedChanges)
 	d.Set("name", ct["name"])
-	d.Set("description", strings.TrimPrefix(ct["description"].(string), warningMessage))
+	d.Set("description", getManagedDescription(ct, meta.(*providerData)))
 	d.Set("display_field", ct["displayField"])
+	d.Set("annotation", annotations)
+	d.Set("taxonomy", taxonomy)
 	d.Set("field", ct["fields"])
+	d.Set("field_changes", []interface{}{})
 
 	return diags
 }
 
 func resourceContentTypeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := meta.(*contentful.Client)
 
 	ids := strings.Split(d.Id(), "/")
 	if len(ids) != 3 {
@@ -269,15 +700,24 @@ func resourceContentTypeUpdate(ctx context.Context, d *schema.ResourceData, meta
 	envID := ids[1]
 	id := ids[2]
 
-	protected := d.Get("protected").(bool)
-	version := d.Get("version").(int)
+	// protected only guards plans, so changing nothing else leaves the content
+	// type as it is instead of saving and publishing a new version
+	if !d.HasChangesExcept("protected") {
+		return diags
+	}
+
+	client := meta.(*providerData).client.Env(envID)
+
+	// keep the prior state when any step fails, so the next plan retries
+	d.Partial(true)
 
 	oldFields, newFields := d.GetChange("field")
 	deletedIDs := getDeletedFieldIDs(oldFields.([]interface{}), newFields.([]interface{}))
 
-	if protected && len(deletedIDs) > 0 {
-		d.Set("field", oldFields)
-		return diag.Errorf("Protected is set to true and these field(s) will be removed: %v", deletedIDs)
+	diags = append(diags, breakingChangeDiagnostics(oldFields.([]interface{}), newFields.([]interface{}), meta.(*providerData).breakingChangePolicy)...)
+	diags = append(diags, checkManagedTag(ctx, client, meta.(*providerData), spaceID, envID)...)
+	if diags.HasError() {
+		return diags
 	}
 
 	body := make(map[string]interface{})
@@ -286,71 +726,248 @@ func resourceContentTypeUpdate(ctx context.Context, d *schema.ResourceData, meta
 		body["name"] = v.(string)
 	}
 
-	body["description"] = warningMessage
-	if v, ok := d.GetOk("description"); ok {
-		body["description"] = warningMessage + v.(string)
-	}
+	setManagedDescription(body, d, meta.(*providerData))
 
 	if v, ok := d.GetOk("display_field"); ok {
 		body["displayField"] = v.(string)
 	}
 
-	if len(deletedIDs) > 0 {
-		fields, err := convertFieldsForWriting(oldFields)
+	// field deletion is resumable, so it starts from the fields as they are
+	// in Contentful rather than from state. The version still has to match
+	// state, so changes made outside of Terraform since the last refresh are
+	// not overwritten. version is planned as unknown, the prior one is in state
+	stateVersion, _ := d.GetChange("version")
+	version := stateVersion.(int)
 
-		if err != nil {
-			return diag.Errorf("Unknown error when converting field: %s", err.Error())
-		}
+	ct, err := client.ContentType.Read(ctx, spaceID, envID, id)
+	if err != nil {
+		return diag.Errorf("Unknown error when getting content type with id:%s : %s", d.Id(), err.Error())
+	}
 
-		for i := 0; i < len(fields.([]interface{})); i++ {
-			field := fields.([]interface{})[i].(map[string]interface{})
-			if deletedIDs[field["id"].(string)] {
-				field["omitted"] = true
-			}
-		}
+	if getVersion(ct) != version {
+		return diag.Errorf("Content type %s was changed outside of Terraform: its version is %d, but %d is in state. Run plan again to review the changes before applying", d.Id(), getVersion(ct), version)
+	}
 
-		body["fields"] = fields
+	renamedIDs := getRenamedFieldIDs(oldFields.([]interface{}), newFields.([]interface{}))
 
-		res, err := client.ContentType.Put(ctx, spaceID, envID, id, version, body)
-		if err != nil {
-			return diag.Errorf("Unknown error when performing upsert: %s", err.Error())
-		}
+	if err := convertMetadataForWriting(body, d.Get("annotation"), d.Get("taxonomy"), newFields, renamedIDs, ct["metadata"]); err != nil {
+		return diag.Errorf("Unknown error when converting metadata: %s", err.Error())
+	}
 
-		version = getVersion(res)
+	progress := make([]string, 0)
 
-		res, err = client.ContentType.Activate(ctx, spaceID, envID, id, version)
+	if len(deletedIDs) > 0 {
+		ct, err = omitFields(ctx, client, spaceID, envID, id, body, ct, deletedIDs, &progress)
 		if err != nil {
-			return diag.Errorf("Unknown error when activating content type: %s", err.Error())
+			return fieldDeletionError(err, progress)
 		}
-
-		version = getVersion(res)
+		version = getVersion(ct)
 	}
 
 	fields, err := convertFieldsForWriting(newFields)
 	if err != nil {
 		return diag.Errorf("Unknown error when converting field: %s", err.Error())
 	}
+
+	for i := 0; i < len(fields.([]interface{})); i++ {
+		field := fields.([]interface{})[i].(map[string]interface{})
+		if previousID, ok := renamedIDs[field["id"].(string)]; ok {
+			field["newId"] = field["id"]
+			field["id"] = previousID
+		}
+	}
+
+	// omitted fields are removed by sending them once more with deleted set
+	remoteFields, _ := ct["fields"].([]interface{})
+	for _, iField := range remoteFields {
+		field := iField.(map[string]interface{})
+		if deletedIDs[field["id"].(string)] {
+			field["deleted"] = true
+			fields = append(fields.([]interface{}), field)
+		}
+	}
+
 	body["fields"] = fields
 
 	res, err := client.ContentType.Put(ctx, spaceID, envID, id, version, body)
 	if err != nil {
+		if len(progress) > 0 {
+			return fieldDeletionError(fmt.Errorf("unknown error when deleting fields: %s", err.Error()), progress)
+		}
 		return diag.Errorf("Unknown error when performing upsert: %s", err.Error())
 	}
 	version = getVersion(res)
 
-	res, err = client.ContentType.Activate(ctx, spaceID, envID, id, version)
-	if err != nil {
-		return diag.Errorf("Unknown error when activating content type: %s", err.Error())
+	if d.Get("publish").(bool) {
+		res, err = client.ContentType.Activate(ctx, spaceID, envID, id, version)
+		if err != nil {
+			if len(progress) > 0 {
+				return fieldDeletionError(fmt.Errorf("unknown error when publishing deleted fields: %s", err.Error()), progress)
+			}
+			return diag.Errorf("Unknown error when activating content type: %s", err.Error())
+		}
 	}
 
+	d.Partial(false)
 	d.Set("version", getVersion(res))
+	d.Set("published_version", getPublishedVersion(res))
+	d.Set("has_unpublished_changes", !isPublished(res))
+
+	if getPublishedVersion(res) > 0 {
+		err = writeAppearance(ctx, client, spaceID, envID, id, newFields)
+		if err != nil {
+			return diag.Errorf("Unknown error when updating editor interface: %s", err.Error())
+		}
+	}
 	return diags
 }
 
+// omitFields is the first step of deleting fields: the fields in deletedIDs
+// are marked omitted and the content type is published, which Contentful
+// requires before they can be deleted. Fields already omitted and published by
+// an earlier, failed apply are skipped. Completed steps are added to progress.
+func omitFields(ctx context.Context, client *contentful.Client, spaceID string, envID string, id string, body map[string]interface{}, ct map[string]interface{}, deletedIDs map[string]bool, progress *[]string) (map[string]interface{}, error) {
+	fields, _ := ct["fields"].([]interface{})
+	omitted := make([]string, 0, len(deletedIDs))
+
+	for _, iField := range fields {
+		field := iField.(map[string]interface{})
+		fieldID := field["id"].(string)
+
+		if deletedIDs[fieldID] && field["omitted"] != true {
+			field["omitted"] = true
+			omitted = append(omitted, fieldID)
+		}
+	}
+
+	if len(omitted) == 0 && isPublished(ct) {
+		return ct, nil
+	}
+
+	version := getVersion(ct)
+
+	if len(omitted) > 0 {
+		omitBody := utils.CopyMap(body)
+		omitBody["fields"] = fields
+
+		res, err := client.ContentType.Put(ctx, spaceID, envID, id, version, omitBody)
+		if err != nil {
+			return nil, fmt.Errorf("unknown error when omitting fields %v: %s", omitted, err.Error())
+		}
+
+		version = getVersion(res)
+		*progress = append(*progress, fmt.Sprintf("omitted fields %v in version %d", omitted, version))
+		log.Printf("[INFO] content type %s: omitted fields %v in version %d", id, omitted, version)
+	}
+
+	res, err := client.ContentType.Activate(ctx, spaceID, envID, id, version)
+	if err != nil {
+		return nil, fmt.Errorf("unknown error when publishing omitted fields: %s", err.Error())
+	}
+
+	*progress = append(*progress, fmt.Sprintf("published version %d", version))
+	log.Printf("[INFO] content type %s: published version %d with omitted fields", id, version)
+
+	return res, nil
+}
+
+// isPublished reports whether the current version of ct is published.
+func isPublished(ct map[string]interface{}) bool {
+	publishedVersion := getPublishedVersion(ct)
+	return publishedVersion > 0 && getVersion(ct) == publishedVersion+1
+}
+
+// getPublishedVersion returns the version of ct that is published, or 0 when
+// it was never published.
+func getPublishedVersion(ct map[string]interface{}) int {
+	sys, _ := ct["sys"].(map[string]interface{})
+	publishedVersion, _ := sys["publishedVersion"].(float64)
+	return int(publishedVersion)
+}
+
+func fieldDeletionError(err error, progress []string) diag.Diagnostics {
+	detail := "No changes were made. Run apply again to retry."
+	if len(progress) > 0 {
+		detail = fmt.Sprintf("Completed steps: %s. Run apply again to resume the field deletion from where it stopped.", strings.Join(progress, ", "))
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  err.Error(),
+			Detail:   detail,
+		},
+	}
+}
+
 func resourceContentTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// replacements of protected content types already fail at plan time
+	if d.Get("protected").(bool) {
+		return diag.Errorf("Protected is set to true, set it to false and apply before destroying content type %s", d.Id())
+	}
+
 	return diag.Errorf("not implemented")
 }
 
+// setManagedDescription writes the description into body, marking the content
+// type as managed by Terraform the way the provider is configured to.
+func setManagedDescription(body map[string]interface{}, d *schema.ResourceData, data *providerData) {
+	description := d.Get("description").(string)
+
+	switch data.managedMarker {
+	case managedMarkerDescription:
+		body["description"] = data.managedDescriptionPrefix + description
+	case managedMarkerTag:
+		body["description"] = description
+		body["metadata"] = map[string]interface{}{
+			"tags": []interface{}{
+				map[string]interface{}{
+					"sys": map[string]interface{}{
+						"type":     "Link",
+						"linkType": "Tag",
+						"id":       data.managedTagID,
+					},
+				},
+			},
+		}
+	default:
+		body["description"] = description
+	}
+}
+
+// checkManagedTag fails when the managed_tag_id tag is missing from the
+// environment, which Contentful would otherwise only report as an invalid link
+// in the metadata.
+func checkManagedTag(ctx context.Context, client *contentful.Client, data *providerData, spaceID string, envID string) diag.Diagnostics {
+	if data.managedMarker != managedMarkerTag {
+		return nil
+	}
+
+	_, err := client.Tag.Read(ctx, spaceID, envID, data.managedTagID)
+
+	if err != nil && strings.Contains(err.Error(), "status code 404") {
+		return diag.Errorf("The managed_tag_id tag %s does not exist in space %s and environment %s. With managed_marker set to tag it has to exist in every environment content types are managed in, e.g. created with contentful_tag", data.managedTagID, spaceID, envID)
+	}
+
+	if err != nil {
+		return diag.Errorf("Unknown error when getting managed tag %s: %s", data.managedTagID, err.Error())
+	}
+
+	return nil
+}
+
+// getManagedDescription returns the description of ct without the managed by
+// Terraform marker. Content types without a description return "".
+func getManagedDescription(ct map[string]interface{}, data *providerData) string {
+	description, _ := ct["description"].(string)
+
+	if data.managedMarker == managedMarkerDescription {
+		return strings.TrimPrefix(description, data.managedDescriptionPrefix)
+	}
+
+	return description
+}
+
 func getVersion(ct map[string]interface{}) int {
 	if ct["sys"] != nil && ct["sys"].(map[string]interface{})["version"] != nil {
 		return int(ct["sys"].(map[string]interface{})["version"].(float64))
@@ -359,57 +976,212 @@ func getVersion(ct map[string]interface{}) int {
 	return 1
 }
 
-func processValidationForReading(validations interface{}) error {
-	v := validations.([]interface{})
+// processValidationForReading splits the validations returned by Contentful
+// into typed validation blocks and canonical JSON strings. Validations the
+// current state keeps as JSON stay JSON so configs using the escape hatch do
+// not flip to typed blocks, and validations are ordered as in the current state
+// so a different order returned by Contentful does not cause a diff.
+func processValidationForReading(validations interface{}, fieldType string, current *currentValidations) ([]interface{}, []interface{}, error) {
+	typed := make([]interface{}, 0)
+	strs := make([]interface{}, 0)
+
+	v, _ := validations.([]interface{})
+	canonical := make([]string, len(v))
 	for i := 0; i < len(v); i++ {
-		res, err := json.Marshal(v[i])
+		res, err := canonicalValidationJSON(v[i])
 
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
 
-		v[i] = string(res)
+		canonical[i] = res
 	}
-	return nil
+
+	indexes := make([]int, len(v))
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	if current != nil {
+		sort.SliceStable(indexes, func(a, b int) bool {
+			return current.position(canonical[indexes[a]]) < current.position(canonical[indexes[b]])
+		})
+	}
+
+	for _, i := range indexes {
+		if vMap, ok := v[i].(map[string]interface{}); ok && (current == nil || !current.raw[canonical[i]]) {
+			if t, ok := convertValidationForReading(vMap, fieldType); ok {
+				typed = append(typed, t)
+				continue
+			}
+		}
+
+		strs = append(strs, canonical[i])
+	}
+	return typed, strs, nil
 }
 
-func processValidationForWriting(validations interface{}) error {
-	v := validations.([]interface{})
+// processValidationForWriting converts typed validation blocks and JSON
+// validation strings into the list of validations sent to Contentful, typed
+// blocks first.
+func processValidationForWriting(typed interface{}, raw interface{}, fieldType string) ([]interface{}, error) {
+	result := make([]interface{}, 0)
+
+	t, _ := typed.([]interface{})
+	for i := 0; i < len(t); i++ {
+		tMap, ok := t[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		vMap, err := convertValidationForWriting(tMap, fieldType)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, vMap)
+	}
+
+	v, _ := raw.([]interface{})
 	for i := 0; i < len(v); i++ {
 		r := v[i]
 		vMap := make(map[string]interface{})
 		err := json.Unmarshal([]byte(r.(string)), &vMap)
 
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		v[i] = vMap
+		result = append(result, vMap)
 	}
-	return nil
+	return result, nil
 }
 
-func convertFieldsForReading(fields interface{}) error {
+// currentValidations describes the validations of a field as they are in
+// state: the canonical JSON of the ones kept as JSON strings, and the position
+// of every validation in the list sent to Contentful.
+type currentValidations struct {
+	raw   map[string]bool
+	order map[string]int
+}
+
+func (c *currentValidations) position(canonical string) int {
+	if i, ok := c.order[canonical]; ok {
+		return i
+	}
+	return len(c.order)
+}
+
+// validationsInState returns the validations currently in state for every
+// field, keyed by field id and by "<field id>.items".
+func validationsInState(fields interface{}) map[string]*currentValidations {
+	result := make(map[string]*currentValidations)
+
+	collect := func(key string, typed interface{}, raw interface{}, fieldType string) {
+		current := &currentValidations{raw: make(map[string]bool), order: make(map[string]int)}
+		result[key] = current
+
+		r, _ := raw.([]interface{})
+		for _, iValidation := range r {
+			if s, ok := iValidation.(string); ok {
+				if canonical, err := canonicalValidationJSON(s); err == nil {
+					current.raw[canonical] = true
+				}
+			}
+		}
+
+		written, err := processValidationForWriting(typed, raw, fieldType)
+		if err != nil {
+			return
+		}
+
+		for i, w := range written {
+			if canonical, err := canonicalValidationJSON(w); err == nil {
+				if _, ok := current.order[canonical]; !ok {
+					current.order[canonical] = i
+				}
+			}
+		}
+	}
+
+	f, _ := fields.([]interface{})
+	for _, iField := range f {
+		field, ok := iField.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		id, _ := field["id"].(string)
+		fieldType, _ := field["type"].(string)
+		collect(id, field["validation"], field["validations"], fieldType)
+
+		if items := singleBlock(field["items"]); items != nil {
+			itemType, _ := items["type"].(string)
+			collect(id+".items", items["validation"], items["validations"], itemType)
+		}
+	}
+
+	return result
+}
+
+// convertFieldsForReading converts the fields returned by Contentful in place
+// into the shape of the field schema. current holds the fields currently in
+// state, or nil when there are none.
+func convertFieldsForReading(fields interface{}, current interface{}) error {
+	inState := validationsInState(current)
+
+	currentFields := make(map[string]map[string]interface{})
+	c, _ := current.([]interface{})
+	for _, iField := range c {
+		if field, ok := iField.(map[string]interface{}); ok {
+			currentFields[field["id"].(string)] = field
+		}
+	}
+
 	for _, f := range fields.([]interface{}) {
 		field := f.(map[string]interface{})
+		id, _ := field["id"].(string)
+		fieldType, _ := field["type"].(string)
+
+		// RichText validations are read into rich_text when the field uses it,
+		// or when there is no field in state to follow yet
+		currentField, inCurrent := currentFields[id]
+		currentRichText, _ := currentField["rich_text"].([]interface{})
+		if field["validations"] != nil && ((inCurrent && len(currentRichText) > 0) || (!inCurrent && fieldType == "RichText")) {
+			if richText, rest, ok := extractRichTextForReading(field["validations"].([]interface{})); ok {
+				field["rich_text"] = []interface{}{richText}
+				field["validations"] = rest
+			}
+		}
 
 		if field["validations"] != nil {
-			err := processValidationForReading(field["validations"])
+			typed, strs, err := processValidationForReading(field["validations"], fieldType, inState[id])
 
 			if err != nil {
 				return fmt.Errorf("unknown error when processing validation: %s", err.Error())
 			}
+
+			if isNumericFieldType(fieldType) {
+				keepNumericInValues(typed, currentField["validation"])
+			}
+
+			field["validation"] = typed
+			field["validations"] = strs
 		}
 
 		utils.ConvertStringField(field, "linkType", "link_type")
-		utils.ConvertMapField(field, "defaultValue", "default_value")
 
-		if field["default_value"] != nil {
-			res, err := json.Marshal(field["default_value"])
+		if previousID, ok := currentField["previous_id"].(string); ok && previousID != "" {
+			field["previous_id"] = previousID
+		}
+
+		if field["defaultValue"] != nil {
+			defaultValues, err := convertDefaultValuesForReading(field["defaultValue"], currentField["default_value"])
 			if err != nil {
-				return err
+				return fmt.Errorf("unknown error when processing default value: %s", err.Error())
 			}
-			field["default_value"] = string(res)
+			field["default_value"] = defaultValues
+			delete(field, "defaultValue")
 		}
 
 		if field["items"] != nil {
@@ -418,10 +1190,15 @@ func convertFieldsForReading(fields interface{}) error {
 		}
 
 		if field["items"] != nil && field["items"].(map[string]interface{})["validations"] != nil {
-			err := processValidationForReading(field["items"].(map[string]interface{})["validations"])
+			items := field["items"].(map[string]interface{})
+			itemType, _ := items["type"].(string)
+			typed, strs, err := processValidationForReading(items["validations"], itemType, inState[id+".items"])
 			if err != nil {
 				return fmt.Errorf("unknown error when processing item validation: %s", err.Error())
 			}
+
+			items["validation"] = typed
+			items["validations"] = strs
 		}
 
 		if field["items"] != nil {
@@ -439,25 +1216,34 @@ func convertFieldsForWriting(original interface{}) (interface{}, error) {
 
 	for _, f := range fields {
 		field := f.(map[string]interface{})
+		fieldType, _ := field["type"].(string)
 
-		if field["validations"] != nil {
-			err := processValidationForWriting(field["validations"])
+		validations, err := processValidationForWriting(field["validation"], field["validations"], fieldType)
 
-			if err != nil {
-				return nil, fmt.Errorf("unknown error when processing validation: %s", err.Error())
-			}
+		if err != nil {
+			return nil, fmt.Errorf("unknown error when processing validation of field %s: %s", field["id"], err.Error())
 		}
 
+		if richText := singleBlock(field["rich_text"]); richText != nil {
+			validations = append(validations, convertRichTextForWriting(richText)...)
+		}
+
+		delete(field, "previous_id")
+		delete(field, "validation")
+		delete(field, "rich_text")
+		delete(field, "annotation")
+		delete(field, "appearance")
+		field["validations"] = validations
+
 		utils.ConvertStringField(field, "link_type", "linkType")
-		utils.ConvertStringField(field, "default_value", "defaultValue")
+		defaultValues, err := convertDefaultValuesForWriting(field["default_value"], fieldType)
+		if err != nil {
+			return nil, fmt.Errorf("unknown error when processing default value of field %s: %s", field["id"], err.Error())
+		}
 
-		if field["defaultValue"] != nil {
-			vMap := make(map[string]interface{})
-			err := json.Unmarshal([]byte(field["defaultValue"].(string)), &vMap)
-			if err != nil {
-				return nil, err
-			}
-			field["defaultValue"] = vMap
+		delete(field, "default_value")
+		if len(defaultValues) > 0 {
+			field["defaultValue"] = defaultValues
 		}
 
 		if field["items"] != nil {
@@ -473,11 +1259,16 @@ func convertFieldsForWriting(original interface{}) (interface{}, error) {
 			utils.ConvertStringField(items, "link_type", "linkType")
 		}
 
-		if field["items"] != nil && field["items"].(map[string]interface{})["validations"] != nil {
-			err := processValidationForWriting(field["items"].(map[string]interface{})["validations"])
+		if field["items"] != nil {
+			items := field["items"].(map[string]interface{})
+			itemType, _ := items["type"].(string)
+			validations, err := processValidationForWriting(items["validation"], items["validations"], itemType)
 			if err != nil {
-				return nil, fmt.Errorf("unknown error when processing item validation: %s", err.Error())
+				return nil, fmt.Errorf("unknown error when processing item validation of field %s: %s", field["id"], err.Error())
 			}
+
+			delete(items, "validation")
+			items["validations"] = validations
 		}
 	}
 	return fields, nil
@@ -492,6 +1283,10 @@ func getDeletedFieldIDs(old, new []interface{}) map[string]bool {
 		newIDs[field["id"].(string)] = true
 	}
 
+	for _, previousID := range getRenamedFieldIDs(old, new) {
+		newIDs[previousID] = true
+	}
+
 	for _, iField := range old {
 		field := iField.(map[string]interface{})
 		if !newIDs[field["id"].(string)] {
@@ -501,3 +1296,28 @@ func getDeletedFieldIDs(old, new []interface{}) map[string]bool {
 
 	return result
 }
+
+// getRenamedFieldIDs returns the previous id of every field being renamed,
+// keyed by its new id. A field is renamed when its previous_id names a field
+// in old and its id does not.
+func getRenamedFieldIDs(old, new []interface{}) map[string]string {
+	result := make(map[string]string)
+
+	oldIDs := make(map[string]bool)
+	for _, iField := range old {
+		field := iField.(map[string]interface{})
+		oldIDs[field["id"].(string)] = true
+	}
+
+	for _, iField := range new {
+		field := iField.(map[string]interface{})
+		id := field["id"].(string)
+		previousID, _ := field["previous_id"].(string)
+
+		if previousID != "" && oldIDs[previousID] && !oldIDs[id] {
+			result[id] = previousID
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/the-urge-tech/terraform-provider-contentful/internal/utils"
+	"github.com/the-urge-tech/terraform-provider-contentful/pkg/contentful"
+)
+
+func appearanceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "The editor widget of the field, written to the editor interface of the content type. Widgets of fields without an appearance block are left as they are. Requires `publish` to be true",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"widget_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The widget id, e.g. `singleLine`, `markdown` or the id of an app or extension",
+				},
+				"widget_namespace": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Computed:    true,
+					Description: "One of `builtin`, `extension`, `app` or `editor-builtin`. Contentful picks it from the widget id when it is not set",
+				},
+				"help_text": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"settings": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Widget settings. Values that are valid JSON, like `true` or `5`, are sent as such, anything else as a string",
+				},
+			},
+		},
+	}
+}
+
+// validateAppearance fails the plan when fields have an appearance block while
+// publish is false. The editor interface is only written after publishing, so
+// the appearance would never be applied and show as a diff on every plan.
+func validateAppearance(d *schema.ResourceDiff) error {
+	if !d.NewValueKnown("publish") || d.Get("publish").(bool) {
+		return nil
+	}
+
+	fields, _ := d.Get("field").([]interface{})
+	for _, iField := range fields {
+		field, ok := iField.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if appearance, _ := field["appearance"].([]interface{}); len(appearance) > 0 {
+			return fmt.Errorf("field %s: appearance can only be set when publish is true, since the editor interface is only written when the content type is published", field["id"])
+		}
+	}
+
+	return nil
+}
+
+// writeAppearance updates the editor interface controls of the fields that
+// have an appearance block. The editor interface only exists once the content
+// type was published.
+func writeAppearance(ctx context.Context, client *contentful.Client, spaceID string, envID string, id string, fields interface{}) error {
+	controls := convertAppearanceForWriting(fields)
+	if len(controls) == 0 {
+		return nil
+	}
+
+	ei, err := client.EditorInterface.Read(ctx, spaceID, envID, id)
+	if err != nil {
+		return err
+	}
+
+	current, _ := ei["controls"].([]interface{})
+	result := make([]interface{}, 0, len(current)+len(controls))
+	for _, iControl := range current {
+		control, _ := iControl.(map[string]interface{})
+		fieldID, _ := control["fieldId"].(string)
+		if c, ok := controls[fieldID]; ok {
+			result = append(result, c)
+			delete(controls, fieldID)
+			continue
+		}
+		result = append(result, iControl)
+	}
+
+	for _, c := range controls {
+		result = append(result, c)
+	}
+
+	body := utils.CopyMap(ei)
+	delete(body, "sys")
+	body["controls"] = result
+
+	_, err = client.EditorInterface.Put(ctx, spaceID, envID, id, getVersion(ei), body)
+	return err
+}
+
+// convertAppearanceForWriting returns the editor interface controls of the
+// fields with an appearance block, keyed by field id.
+func convertAppearanceForWriting(fields interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+
+	f, _ := fields.([]interface{})
+	for _, iField := range f {
+		field, _ := iField.(map[string]interface{})
+		appearance := singleBlock(field["appearance"])
+		if appearance == nil {
+			continue
+		}
+
+		fieldID, _ := field["id"].(string)
+		control := map[string]interface{}{
+			"fieldId":  fieldID,
+			"widgetId": appearance["widget_id"],
+		}
+
+		if namespace, _ := appearance["widget_namespace"].(string); namespace != "" {
+			control["widgetNamespace"] = namespace
+		}
+
+		settings := make(map[string]interface{})
+		s, _ := appearance["settings"].(map[string]interface{})
+		for k, v := range s {
+			settings[k] = settingForWriting(v.(string))
+		}
+		if helpText, _ := appearance["help_text"].(string); helpText != "" {
+			settings["helpText"] = helpText
+		}
+		if len(settings) > 0 {
+			control["settings"] = settings
+		}
+
+		result[fieldID] = control
+	}
+
+	return result
+}
+
+// readAppearance sets the appearance block of the fields read by
+// convertFieldsForReading that have one in current. A missing editor
+// interface leaves them without one.
+func readAppearance(ctx context.Context, client *contentful.Client, spaceID string, envID string, id string, fields interface{}, current interface{}) error {
+	declared := make(map[string]bool)
+	c, _ := current.([]interface{})
+	for _, iField := range c {
+		field, _ := iField.(map[string]interface{})
+		if singleBlock(field["appearance"]) != nil {
+			declared[field["id"].(string)] = true
+		}
+	}
+
+	if len(declared) == 0 {
+		return nil
+	}
+
+	ei, err := client.EditorInterface.Read(ctx, spaceID, envID, id)
+	if err != nil && strings.Contains(err.Error(), "status code 404") {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	controls := make(map[string]map[string]interface{})
+	eiControls, _ := ei["controls"].([]interface{})
+	for _, iControl := range eiControls {
+		control, _ := iControl.(map[string]interface{})
+		fieldID, _ := control["fieldId"].(string)
+		controls[fieldID] = control
+	}
+
+	f, _ := fields.([]interface{})
+	for _, iField := range f {
+		field, _ := iField.(map[string]interface{})
+		fieldID, _ := field["id"].(string)
+		if !declared[fieldID] {
+			continue
+		}
+
+		control, ok := controls[fieldID]
+		if !ok || control["widgetId"] == nil {
+			field["appearance"] = []interface{}{}
+			continue
+		}
+
+		appearance := map[string]interface{}{
+			"widget_id":        control["widgetId"],
+			"widget_namespace": control["widgetNamespace"],
+		}
+
+		settings := make(map[string]interface{})
+		s, _ := control["settings"].(map[string]interface{})
+		for k, v := range s {
+			if k == "helpText" {
+				appearance["help_text"] = v
+				continue
+			}
+			settings[k] = settingForReading(v)
+		}
+		appearance["settings"] = settings
+
+		field["appearance"] = []interface{}{appearance}
+	}
+
+	return nil
+}
+
+func settingForWriting(v string) interface{} {
+	var value interface{}
+	if err := json.Unmarshal([]byte(v), &value); err != nil {
+		return v
+	}
+	return value
+}
+
+// settingForReading is the inverse of settingForWriting. Strings that would
+// be sent as another JSON type are quoted.
+func settingForReading(v interface{}) string {
+	if s, ok := v.(string); ok {
+		if w, isString := settingForWriting(s).(string); isString && w == s {
+			return s
+		}
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
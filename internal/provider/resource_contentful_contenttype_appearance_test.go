@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/the-urge-tech/terraform-provider-contentful/pkg/contentful"
+)
+
+func TestValidateAppearance(t *testing.T) {
+	meta := &providerData{client: contentful.NewClient("token", "org", "space", "master")}
+
+	raw := func(publish bool) map[string]interface{} {
+		return map[string]interface{}{
+			"space_id":        "space",
+			"content_type_id": "blog",
+			"name":            "Blog",
+			"display_field":   "title",
+			"publish":         publish,
+			"field": []interface{}{
+				map[string]interface{}{
+					"id":         "title",
+					"name":       "Title",
+					"type":       "Symbol",
+					"appearance": []interface{}{map[string]interface{}{"widget_id": "singleLine"}},
+				},
+			},
+		}
+	}
+
+	// the raw config is only available in plans made by Terraform, so diff
+	// against an existing content type
+	state := schema.TestResourceDataRaw(t, resourceContentTypeSchema(), raw(true))
+	state.Set("env_id", "master")
+	state.SetId("space/master/blog")
+
+	config := func(publish bool) *terraform.ResourceConfig {
+		c := raw(publish)
+		c["env_id"] = "master"
+		return terraform.NewResourceConfigRaw(c)
+	}
+
+	_, err := resourceContentfulContentType().Diff(context.Background(), state.State(), config(false), meta)
+	if err == nil || !strings.Contains(err.Error(), "appearance can only be set when publish is true") {
+		t.Errorf("expected the plan to fail, got %v", err)
+	}
+
+	if _, err := resourceContentfulContentType().Diff(context.Background(), state.State(), config(true), meta); err != nil {
+		t.Errorf("expected the plan to succeed, got %v", err)
+	}
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// getBreakingFieldChanges describes the changes from old to new fields that
+// Contentful rejects or that invalidate existing entries. known reports
+// whether an attribute of the new field at the given index is known, fields
+// with unknown attributes are skipped.
+func getBreakingFieldChanges(old, new []interface{}, known func(i int, key string) bool) []string {
+	result := make([]string, 0)
+	renamedIDs := getRenamedFieldIDs(old, new)
+
+	oldByID := make(map[string]map[string]interface{})
+	for _, iField := range old {
+		field := iField.(map[string]interface{})
+		oldByID[field["id"].(string)] = field
+	}
+
+	for i, iField := range new {
+		field := iField.(map[string]interface{})
+		id := field["id"].(string)
+
+		oldID := id
+		if previousID, ok := renamedIDs[id]; ok {
+			oldID = previousID
+		}
+
+		oldField, ok := oldByID[oldID]
+		if !ok {
+			continue
+		}
+
+		if known(i, "type") && oldField["type"] != field["type"] {
+			result = append(result, fmt.Sprintf("field %s: type changes from %v to %v", id, oldField["type"], field["type"]))
+		}
+
+		if known(i, "link_type") && oldField["link_type"] != field["link_type"] {
+			result = append(result, fmt.Sprintf("field %s: link_type changes from %q to %q", id, oldField["link_type"], field["link_type"]))
+		}
+
+		if known(i, "localized") && oldField["localized"] != field["localized"] {
+			result = append(result, fmt.Sprintf("field %s: localized changes from %v to %v", id, oldField["localized"], field["localized"]))
+		}
+
+		if known(i, "required") && oldField["required"] != true && field["required"] == true {
+			result = append(result, fmt.Sprintf("field %s: becomes required", id))
+		}
+
+		oldItems := singleBlock(oldField["items"])
+		items := singleBlock(field["items"])
+		if oldItems == nil || items == nil {
+			continue
+		}
+
+		if known(i, "items.0.type") && oldItems["type"] != items["type"] {
+			result = append(result, fmt.Sprintf("field %s: items type changes from %v to %v", id, oldItems["type"], items["type"]))
+		}
+
+		if known(i, "items.0.link_type") && oldItems["link_type"] != items["link_type"] {
+			result = append(result, fmt.Sprintf("field %s: items link_type changes from %q to %q", id, oldItems["link_type"], items["link_type"]))
+		}
+	}
+
+	return result
+}
+
+// validateBreakingChanges fails the plan on breaking field changes when the
+// provider breaking_change_policy is error.
+func validateBreakingChanges(d *schema.ResourceDiff, policy string) error {
+	if d.Id() == "" || policy != breakingChangeError || !d.HasChange("field") {
+		return nil
+	}
+
+	o, n := d.GetChange("field")
+	changes := getBreakingFieldChanges(o.([]interface{}), n.([]interface{}), func(i int, key string) bool {
+		return d.NewValueKnown(fmt.Sprintf("field.%d.%s", i, key))
+	})
+
+	if len(changes) > 0 {
+		return fmt.Errorf("the plan contains breaking field changes, set the provider breaking_change_policy to warn or allow to apply them anyway:\n  %s", strings.Join(changes, "\n  "))
+	}
+
+	return nil
+}
+
+// breakingChangeDiagnostics reports breaking field changes according to the
+// provider breaking_change_policy.
+func breakingChangeDiagnostics(old, new []interface{}, policy string) diag.Diagnostics {
+	if policy == breakingChangeAllow {
+		return nil
+	}
+
+	changes := getBreakingFieldChanges(old, new, func(int, string) bool { return true })
+	if len(changes) == 0 {
+		return nil
+	}
+
+	severity := diag.Warning
+	if policy == breakingChangeError {
+		severity = diag.Error
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: severity,
+			Summary:  "Breaking field changes",
+			Detail:   strings.Join(changes, "\n"),
+		},
+	}
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestGetBreakingFieldChanges(t *testing.T) {
+	field := func(id string, fieldType string, localized bool, required bool) map[string]interface{} {
+		return map[string]interface{}{"id": id, "type": fieldType, "link_type": "", "localized": localized, "required": required}
+	}
+	known := func(int, string) bool { return true }
+
+	old := []interface{}{
+		field("title", "Symbol", false, false),
+		field("body", "Text", false, true),
+		field("tags", "Array", false, false),
+	}
+	old[2].(map[string]interface{})["items"] = []interface{}{map[string]interface{}{"type": "Symbol", "link_type": ""}}
+
+	renamedTitle := field("headline", "Text", true, true)
+	renamedTitle["previous_id"] = "title"
+
+	tags := field("tags", "Array", false, false)
+	tags["items"] = []interface{}{map[string]interface{}{"type": "Link", "link_type": "Entry"}}
+
+	new := []interface{}{
+		renamedTitle,
+		field("body", "Text", false, false),
+		tags,
+		field("added", "Symbol", true, true),
+	}
+
+	want := []string{
+		"field headline: type changes from Symbol to Text",
+		"field headline: localized changes from false to true",
+		"field headline: becomes required",
+		"field tags: items type changes from Symbol to Link",
+		`field tags: items link_type changes from "" to "Entry"`,
+	}
+
+	if got := getBreakingFieldChanges(old, new, known); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	unknown := func(int, string) bool { return false }
+	if got := getBreakingFieldChanges(old, new, unknown); len(got) != 0 {
+		t.Errorf("expected no changes for unknown attributes, got %q", got)
+	}
+}
+
+func TestBreakingChangeDiagnostics(t *testing.T) {
+	old := []interface{}{map[string]interface{}{"id": "title", "type": "Symbol"}}
+	new := []interface{}{map[string]interface{}{"id": "title", "type": "Text"}}
+
+	cases := []struct {
+		policy   string
+		severity diag.Severity
+		count    int
+	}{
+		{policy: breakingChangeError, severity: diag.Error, count: 1},
+		{policy: breakingChangeWarn, severity: diag.Warning, count: 1},
+		{policy: breakingChangeAllow, count: 0},
+	}
+
+	for _, c := range cases {
+		t.Run(c.policy, func(t *testing.T) {
+			diags := breakingChangeDiagnostics(old, new, c.policy)
+			if len(diags) != c.count {
+				t.Fatalf("got %d diagnostics, want %d", len(diags), c.count)
+			}
+			if c.count > 0 && diags[0].Severity != c.severity {
+				t.Errorf("got severity %v, want %v", diags[0].Severity, c.severity)
+			}
+		})
+	}
+}
+
+func TestBreakingChangePolicyDefault(t *testing.T) {
+	// upgrading the provider must not fail plans of existing configs
+	if got := New("test")().Schema["breaking_change_policy"].Default; got != breakingChangeWarn {
+		t.Errorf("got default %v, want %s", got, breakingChangeWarn)
+	}
+}
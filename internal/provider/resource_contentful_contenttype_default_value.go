@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dateLayouts are the date formats Contentful accepts for Date fields.
+var dateLayouts = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02T15:04", "2006-01-02"}
+
+func defaultValueSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "The default value of the field for a locale",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"locale": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"value": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The default value. Integer and Number fields take a number, Boolean fields `true` or `false` and Date fields an ISO 8601 date",
+				},
+			},
+		},
+	}
+}
+
+// convertDefaultValueForWriting converts a single default value into the type
+// Contentful expects for fieldType.
+func convertDefaultValueForWriting(value string, fieldType string) (interface{}, error) {
+	switch fieldType {
+	case "Symbol", "Text":
+		return value, nil
+	case "Integer":
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("expected an integer, got %q", value)
+		}
+		return i, nil
+	case "Number":
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("expected a number, got %q", value)
+		}
+		return f, nil
+	case "Boolean":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("expected true or false, got %q", value)
+		}
+		return b, nil
+	case "Date":
+		for _, layout := range dateLayouts {
+			if _, err := time.Parse(layout, value); err == nil {
+				return value, nil
+			}
+		}
+		return nil, fmt.Errorf("expected an ISO 8601 date, got %q", value)
+	}
+
+	return nil, fmt.Errorf("default values are not supported for %s fields", fieldType)
+}
+
+// convertDefaultValuesForWriting converts default_value blocks into the
+// per-locale map sent to Contentful.
+func convertDefaultValuesForWriting(values interface{}, fieldType string) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+
+	v, _ := values.([]interface{})
+	for _, iValue := range v {
+		value, ok := iValue.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		locale := value["locale"].(string)
+		if _, ok := result[locale]; ok {
+			return nil, fmt.Errorf("default_value for locale %s is set more than once", locale)
+		}
+
+		converted, err := convertDefaultValueForWriting(value["value"].(string), fieldType)
+		if err != nil {
+			return nil, fmt.Errorf("default_value for locale %s: %s", locale, err.Error())
+		}
+
+		result[locale] = converted
+	}
+
+	return result, nil
+}
+
+// convertDefaultValuesForReading converts the per-locale default value map
+// returned by Contentful into default_value blocks, ordered like the blocks in
+// current and by locale otherwise.
+func convertDefaultValuesForReading(values interface{}, current interface{}) ([]interface{}, error) {
+	v, _ := values.(map[string]interface{})
+
+	order := make(map[string]int)
+	c, _ := current.([]interface{})
+	for i, iValue := range c {
+		if value, ok := iValue.(map[string]interface{}); ok {
+			order[value["locale"].(string)] = i
+		}
+	}
+
+	locales := make([]string, 0, len(v))
+	for locale := range v {
+		locales = append(locales, locale)
+	}
+	sort.Slice(locales, func(i, j int) bool {
+		oi, iok := order[locales[i]]
+		oj, jok := order[locales[j]]
+		if iok && jok {
+			return oi < oj
+		}
+		if iok != jok {
+			return iok
+		}
+		return locales[i] < locales[j]
+	})
+
+	result := make([]interface{}, 0, len(locales))
+	for _, locale := range locales {
+		var value string
+
+		switch t := v[locale].(type) {
+		case string:
+			value = t
+		case float64:
+			value = strconv.FormatFloat(t, 'f', -1, 64)
+		case bool:
+			value = strconv.FormatBool(t)
+		default:
+			res, err := json.Marshal(t)
+			if err != nil {
+				return nil, err
+			}
+			value = string(res)
+		}
+
+		result = append(result, map[string]interface{}{"locale": locale, "value": value})
+	}
+
+	return result, nil
+}
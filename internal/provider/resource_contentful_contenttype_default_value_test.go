@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestConvertDefaultValueForWriting(t *testing.T) {
+	cases := []struct {
+		name      string
+		value     string
+		fieldType string
+		want      interface{}
+		wantErr   bool
+	}{
+		{name: "Symbol", value: "hello", fieldType: "Symbol", want: "hello"},
+		{name: "Text", value: "", fieldType: "Text", want: ""},
+		{name: "Integer", value: "42", fieldType: "Integer", want: int64(42)},
+		{name: "Integer with a fraction", value: "4.2", fieldType: "Integer", wantErr: true},
+		{name: "Number", value: "4.2", fieldType: "Number", want: 4.2},
+		{name: "Number with text", value: "four", fieldType: "Number", wantErr: true},
+		{name: "Boolean", value: "true", fieldType: "Boolean", want: true},
+		{name: "Boolean with text", value: "yes", fieldType: "Boolean", wantErr: true},
+		{name: "Date", value: "2021-01-02", fieldType: "Date", want: "2021-01-02"},
+		{name: "Date and time", value: "2021-01-02T10:00:00Z", fieldType: "Date", want: "2021-01-02T10:00:00Z"},
+		{name: "Date with text", value: "tomorrow", fieldType: "Date", wantErr: true},
+		{name: "unsupported type", value: "{}", fieldType: "Object", wantErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := convertDefaultValueForWriting(c.value, c.fieldType)
+			if c.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != c.want {
+				t.Errorf("got %#v, want %#v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestConvertDefaultValuesForWriting(t *testing.T) {
+	values := []interface{}{
+		map[string]interface{}{"locale": "en-US", "value": "1"},
+		map[string]interface{}{"locale": "de-DE", "value": "2"},
+	}
+
+	got, err := convertDefaultValuesForWriting(values, "Integer")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]interface{}{"en-US": int64(1), "de-DE": int64(2)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	duplicate := append(values, map[string]interface{}{"locale": "en-US", "value": "3"})
+	if _, err := convertDefaultValuesForWriting(duplicate, "Integer"); err == nil {
+		t.Error("expected an error for a locale set twice")
+	}
+}
+
+func TestConvertDefaultValuesForReading(t *testing.T) {
+	cases := []struct {
+		name      string
+		fieldType string
+		json      string
+		current   []interface{}
+		want      []interface{}
+	}{
+		{
+			name:      "Symbol ordered by locale",
+			fieldType: "Symbol",
+			json:      `{"en-US":"hello","de-DE":"hallo"}`,
+			want: []interface{}{
+				map[string]interface{}{"locale": "de-DE", "value": "hallo"},
+				map[string]interface{}{"locale": "en-US", "value": "hello"},
+			},
+		},
+		{
+			name:      "Integer ordered like the state",
+			fieldType: "Integer",
+			json:      `{"en-US":1,"de-DE":2}`,
+			current: []interface{}{
+				map[string]interface{}{"locale": "en-US", "value": "1"},
+				map[string]interface{}{"locale": "de-DE", "value": "2"},
+			},
+			want: []interface{}{
+				map[string]interface{}{"locale": "en-US", "value": "1"},
+				map[string]interface{}{"locale": "de-DE", "value": "2"},
+			},
+		},
+		{
+			name:      "Number",
+			fieldType: "Number",
+			json:      `{"en-US":1.5}`,
+			want:      []interface{}{map[string]interface{}{"locale": "en-US", "value": "1.5"}},
+		},
+		{
+			name:      "Boolean",
+			fieldType: "Boolean",
+			json:      `{"en-US":false}`,
+			want:      []interface{}{map[string]interface{}{"locale": "en-US", "value": "false"}},
+		},
+		{
+			name:      "Date",
+			fieldType: "Date",
+			json:      `{"en-US":"2021-01-02T10:00"}`,
+			want:      []interface{}{map[string]interface{}{"locale": "en-US", "value": "2021-01-02T10:00"}},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			values := make(map[string]interface{})
+			if err := json.Unmarshal([]byte(c.json), &values); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := convertDefaultValuesForReading(values, c.current)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, c.want) {
+				t.Fatalf("got %v, want %v", got, c.want)
+			}
+
+			// writing what was read sends the same values back
+			written, err := convertDefaultValuesForWriting(got, c.fieldType)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !jsonEqual(written, values) {
+				t.Errorf("round trip changed the default values: got %v, want %v", written, values)
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/the-urge-tech/terraform-provider-contentful/internal/utils"
+)
+
+func fieldChangesSchema() *schema.Schema {
+	ids := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: description,
+		}
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Summary of the planned field changes, with fields matched by id. Only set in plans that change `field`.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"added":        ids("Ids of added fields"),
+				"removed":      ids("Ids of removed fields"),
+				"modified":     ids("Ids of fields with changed attributes other than their type"),
+				"reordered":    ids("Ids of fields that moved relative to the other fields"),
+				"type_changed": ids("Ids of fields whose `type`, `link_type` or `items` type changed"),
+				"renamed":      ids("Renamed fields as `previous_id -> id`"),
+				"breaking":     ids("Breaking field changes, unless the provider `breaking_change_policy` is `allow`"),
+			},
+		},
+	}
+}
+
+// setFieldChanges plans the field_changes summary of the field diff. Breaking
+// changes are part of it so they show up in the plan, which is where the warn
+// breaking_change_policy warns about them.
+func setFieldChanges(d *schema.ResourceDiff, policy string) error {
+	if !d.HasChange("field") {
+		return nil
+	}
+
+	o, n := d.GetChange("field")
+	newFields, _ := n.([]interface{})
+
+	for i := range newFields {
+		if !d.NewValueKnown(fmt.Sprintf("field.%d.id", i)) {
+			return d.SetNewComputed("field_changes")
+		}
+	}
+
+	oldFields, _ := o.([]interface{})
+	changes := getFieldChanges(oldFields, newFields)
+
+	breaking := make([]interface{}, 0)
+	if d.Id() != "" && policy != breakingChangeAllow {
+		for _, change := range getBreakingFieldChanges(oldFields, newFields, func(i int, key string) bool {
+			return d.NewValueKnown(fmt.Sprintf("field.%d.%s", i, key))
+		}) {
+			log.Printf("[WARN] content type %s: breaking field change: %s", d.Id(), change)
+			breaking = append(breaking, change)
+		}
+	}
+	changes["breaking"] = breaking
+
+	return d.SetNew("field_changes", []interface{}{changes})
+}
+
+func getFieldChanges(old, new []interface{}) map[string]interface{} {
+	added := make([]interface{}, 0)
+	removed := make([]interface{}, 0)
+	modified := make([]interface{}, 0)
+	typeChanged := make([]interface{}, 0)
+	renamed := make([]interface{}, 0)
+
+	renamedIDs := getRenamedFieldIDs(old, new)
+	deletedIDs := getDeletedFieldIDs(old, new)
+
+	oldByID := make(map[string]map[string]interface{})
+	oldOrder := make([]string, 0, len(old))
+	for _, iField := range old {
+		field := iField.(map[string]interface{})
+		id := field["id"].(string)
+		oldByID[id] = field
+		oldOrder = append(oldOrder, id)
+
+		if deletedIDs[id] {
+			removed = append(removed, id)
+		}
+	}
+
+	newOrder := make([]string, 0, len(new))
+	for _, iField := range new {
+		field := iField.(map[string]interface{})
+		id := field["id"].(string)
+
+		oldID := id
+		if previousID, ok := renamedIDs[id]; ok {
+			oldID = previousID
+			renamed = append(renamed, fmt.Sprintf("%s -> %s", previousID, id))
+		}
+
+		oldField, ok := oldByID[oldID]
+		if !ok {
+			added = append(added, id)
+			continue
+		}
+
+		newOrder = append(newOrder, oldID)
+
+		if fieldTypeOf(oldField) != fieldTypeOf(field) {
+			typeChanged = append(typeChanged, id)
+		} else if !fieldsEqual(oldField, field) {
+			modified = append(modified, id)
+		}
+	}
+
+	commonOrder := make([]string, 0, len(oldOrder))
+	for _, id := range oldOrder {
+		if !deletedIDs[id] {
+			commonOrder = append(commonOrder, id)
+		}
+	}
+
+	reordered := make([]interface{}, 0)
+	for _, id := range movedIDs(commonOrder, newOrder) {
+		for newID, previousID := range renamedIDs {
+			if previousID == id {
+				id = newID
+			}
+		}
+		reordered = append(reordered, id)
+	}
+
+	return map[string]interface{}{
+		"added":        added,
+		"removed":      removed,
+		"modified":     modified,
+		"reordered":    reordered,
+		"type_changed": typeChanged,
+		"renamed":      renamed,
+	}
+}
+
+// fieldTypeOf describes everything about the type of a field that Contentful
+// treats as a type change.
+func fieldTypeOf(field map[string]interface{}) string {
+	fieldType := fmt.Sprintf("%v/%v", field["type"], field["link_type"])
+	if items := singleBlock(field["items"]); items != nil {
+		fieldType += fmt.Sprintf("/%v/%v", items["type"], items["link_type"])
+	}
+	return fieldType
+}
+
+// fieldsEqual compares two fields ignoring differences validationDiff
+// suppresses and the previous_id used to match them.
+func fieldsEqual(a, b map[string]interface{}) bool {
+	normalize := func(field map[string]interface{}) map[string]interface{} {
+		f := utils.CopyMap(field)
+		delete(f, "id")
+		delete(f, "previous_id")
+		f["validations"] = canonicalValidationSet(f["validations"])
+		if items := singleBlock(f["items"]); items != nil {
+			items["validations"] = canonicalValidationSet(items["validations"])
+		}
+		return f
+	}
+
+	return reflect.DeepEqual(normalize(a), normalize(b))
+}
+
+func canonicalValidationSet(validations interface{}) []string {
+	v, _ := validations.([]interface{})
+	result := make([]string, 0, len(v))
+	for _, e := range v {
+		canonical, err := canonicalValidationJSON(e)
+		if err != nil {
+			canonical = fmt.Sprintf("%v", e)
+		}
+		result = append(result, canonical)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// movedIDs returns the ids of new that are not part of the longest common
+// subsequence of old and new, i.e. the fewest fields that have to move to turn
+// the old order into the new one.
+func movedIDs(old, new []string) []string {
+	lengths := make([][]int, len(old)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(new)+1)
+	}
+
+	for i := len(old) - 1; i >= 0; i-- {
+		for j := len(new) - 1; j >= 0; j-- {
+			if old[i] == new[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else if lengths[i+1][j] >= lengths[i][j+1] {
+				lengths[i][j] = lengths[i+1][j]
+			} else {
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+
+	kept := make(map[string]bool)
+	for i, j := 0, 0; i < len(old) && j < len(new); {
+		switch {
+		case old[i] == new[j]:
+			kept[new[j]] = true
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+
+	result := make([]string, 0)
+	for _, id := range new {
+		if !kept[id] {
+			result = append(result, id)
+		}
+	}
+	return result
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMovedIDs(t *testing.T) {
+	cases := []struct {
+		name string
+		old  []string
+		new  []string
+		want []string
+	}{
+		{name: "unchanged", old: []string{"a", "b", "c"}, new: []string{"a", "b", "c"}, want: []string{}},
+		{name: "one moved to the front", old: []string{"a", "b", "c"}, new: []string{"c", "a", "b"}, want: []string{"c"}},
+		{name: "one moved to the end", old: []string{"a", "b", "c", "d"}, new: []string{"b", "c", "d", "a"}, want: []string{"a"}},
+		// of equally short answers, the fields earlier in the old order move
+		{name: "swapped", old: []string{"a", "b"}, new: []string{"b", "a"}, want: []string{"a"}},
+		{name: "reversed", old: []string{"a", "b", "c"}, new: []string{"c", "b", "a"}, want: []string{"b", "a"}},
+		{name: "empty", old: []string{}, new: []string{}, want: []string{}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := movedIDs(c.old, c.new); !reflect.DeepEqual(got, c.want) {
+				t.Errorf("got %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestGetFieldChanges(t *testing.T) {
+	field := func(id string, fieldType string, name string) interface{} {
+		return map[string]interface{}{"id": id, "type": fieldType, "name": name, "previous_id": ""}
+	}
+	renamed := func(id string, previousID string) interface{} {
+		return map[string]interface{}{"id": id, "type": "Symbol", "name": id, "previous_id": previousID}
+	}
+
+	old := []interface{}{
+		field("title", "Symbol", "title"),
+		field("body", "Text", "body"),
+		field("count", "Integer", "count"),
+		field("slug", "Symbol", "slug"),
+		field("old", "Symbol", "old"),
+	}
+	new := []interface{}{
+		renamed("headline", "title"),
+		field("body", "Text", "Body"),
+		field("slug", "Symbol", "slug"),
+		field("count", "Number", "count"),
+		field("extra", "Symbol", "extra"),
+	}
+
+	want := map[string]interface{}{
+		"added":        []interface{}{"extra"},
+		"removed":      []interface{}{"old"},
+		"modified":     []interface{}{"headline", "body"},
+		"reordered":    []interface{}{"count"},
+		"type_changed": []interface{}{"count"},
+		"renamed":      []interface{}{"title -> headline"},
+	}
+
+	if got := getFieldChanges(old, new); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
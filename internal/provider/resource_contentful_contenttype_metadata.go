@@ -0,0 +1,301 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func annotationSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The annotation id, e.g. `Contentful:AggregateRoot`",
+				},
+				"parameters": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					StateFunc:   jsonStateFunc,
+					Description: "JSON object with the parameters of the annotation",
+				},
+			},
+		},
+	}
+}
+
+func taxonomySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Taxonomy concepts and concept schemes entries of this content type can be tagged with",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"concept_scheme_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Id of a concept scheme. Exactly one of `concept_scheme_id` and `concept_id` must be set",
+				},
+				"concept_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Id of a concept. Exactly one of `concept_scheme_id` and `concept_id` must be set",
+				},
+				"required": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Whether entries must be tagged with the concept or a concept of the scheme",
+				},
+			},
+		},
+	}
+}
+
+// jsonStateFunc stores JSON with its keys sorted and without whitespace, so
+// formatting differences do not show up in plans.
+func jsonStateFunc(v interface{}) string {
+	s, _ := v.(string)
+
+	var value interface{}
+	if err := json.Unmarshal([]byte(s), &value); err != nil {
+		return s
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return s
+	}
+	return string(b)
+}
+
+// validateTaxonomy checks that every taxonomy block links exactly one concept
+// or concept scheme.
+func validateTaxonomy(d *schema.ResourceDiff) error {
+	taxonomy, _ := d.Get("taxonomy").([]interface{})
+	for i, iTaxonomy := range taxonomy {
+		if !d.NewValueKnown(fmt.Sprintf("taxonomy.%d.concept_scheme_id", i)) || !d.NewValueKnown(fmt.Sprintf("taxonomy.%d.concept_id", i)) {
+			continue
+		}
+
+		t, _ := iTaxonomy.(map[string]interface{})
+		schemeID, _ := t["concept_scheme_id"].(string)
+		conceptID, _ := t["concept_id"].(string)
+
+		if (schemeID == "") == (conceptID == "") {
+			return fmt.Errorf("taxonomy %d: exactly one of concept_scheme_id and concept_id must be set", i)
+		}
+	}
+
+	return nil
+}
+
+// convertMetadataForWriting merges the annotations of the content type and its
+// fields and the taxonomy into the metadata of body. Field annotations are
+// keyed by the id the field is sent with, which is its previous id while it
+// is renamed. Metadata Terraform does not manage, like tags set in the web
+// app, is kept from remote, the metadata of the content type in Contentful,
+// since a content type saved without it loses it.
+func convertMetadataForWriting(body map[string]interface{}, annotations interface{}, taxonomy interface{}, fields interface{}, renamedIDs map[string]string, remote interface{}) error {
+	metadata := make(map[string]interface{})
+	r, _ := remote.(map[string]interface{})
+	for k, v := range r {
+		if k != "annotations" && k != "taxonomy" {
+			metadata[k] = v
+		}
+	}
+
+	// the managed tag set by setManagedDescription joins the remote tags
+	if m, ok := body["metadata"].(map[string]interface{}); ok {
+		metadata["tags"] = mergeTags(metadata["tags"], m["tags"])
+	}
+
+	contentTypeAnnotations, err := convertAnnotationsForWriting(annotations)
+	if err != nil {
+		return err
+	}
+
+	fieldAnnotations := make(map[string]interface{})
+	f, _ := fields.([]interface{})
+	for _, iField := range f {
+		field, _ := iField.(map[string]interface{})
+		id, _ := field["id"].(string)
+
+		a, err := convertAnnotationsForWriting(field["annotation"])
+		if err != nil {
+			return fmt.Errorf("field %s: %s", id, err.Error())
+		}
+
+		if len(a) == 0 {
+			continue
+		}
+
+		if previousID, ok := renamedIDs[id]; ok {
+			id = previousID
+		}
+		fieldAnnotations[id] = a
+	}
+
+	if len(contentTypeAnnotations) > 0 || len(fieldAnnotations) > 0 {
+		a := make(map[string]interface{})
+		if len(contentTypeAnnotations) > 0 {
+			a["ContentType"] = contentTypeAnnotations
+		}
+		if len(fieldAnnotations) > 0 {
+			a["ContentTypeField"] = fieldAnnotations
+		}
+		metadata["annotations"] = a
+	}
+
+	t, _ := taxonomy.([]interface{})
+	if len(t) > 0 {
+		result := make([]interface{}, 0, len(t))
+		for _, iTaxonomy := range t {
+			tax, _ := iTaxonomy.(map[string]interface{})
+			linkType, id := "TaxonomyConceptScheme", tax["concept_scheme_id"]
+			if conceptID, _ := tax["concept_id"].(string); conceptID != "" {
+				linkType, id = "TaxonomyConcept", conceptID
+			}
+
+			required, _ := tax["required"].(bool)
+			result = append(result, map[string]interface{}{
+				"sys":      link(linkType, id),
+				"required": required,
+			})
+		}
+		metadata["taxonomy"] = result
+	}
+
+	if len(metadata) > 0 || r != nil {
+		body["metadata"] = metadata
+	}
+
+	return nil
+}
+
+// mergeTags returns the tag links of tags followed by the ones of added that
+// are not in tags yet.
+func mergeTags(tags interface{}, added interface{}) []interface{} {
+	t, _ := tags.([]interface{})
+	result := append(make([]interface{}, 0, len(t)), t...)
+
+	ids := make(map[interface{}]bool)
+	for _, iTag := range t {
+		tag, _ := iTag.(map[string]interface{})
+		sys, _ := tag["sys"].(map[string]interface{})
+		ids[sys["id"]] = true
+	}
+
+	a, _ := added.([]interface{})
+	for _, iTag := range a {
+		tag, _ := iTag.(map[string]interface{})
+		sys, _ := tag["sys"].(map[string]interface{})
+		if !ids[sys["id"]] {
+			result = append(result, iTag)
+		}
+	}
+
+	return result
+}
+
+func convertAnnotationsForWriting(annotations interface{}) ([]interface{}, error) {
+	a, _ := annotations.([]interface{})
+	result := make([]interface{}, 0, len(a))
+
+	for _, iAnnotation := range a {
+		annotation, _ := iAnnotation.(map[string]interface{})
+		r := map[string]interface{}{
+			"sys": link("Annotation", annotation["id"]),
+		}
+
+		if parameters, _ := annotation["parameters"].(string); parameters != "" {
+			var p map[string]interface{}
+			if err := json.Unmarshal([]byte(parameters), &p); err != nil {
+				return nil, fmt.Errorf("parameters of annotation %v must be a JSON object: %s", annotation["id"], err.Error())
+			}
+			r["parameters"] = p
+		}
+
+		result = append(result, r)
+	}
+
+	return result, nil
+}
+
+// convertMetadataForReading returns the content type annotations, the
+// taxonomy and the field annotations keyed by field id found in metadata.
+func convertMetadataForReading(metadata interface{}) ([]interface{}, []interface{}, map[string][]interface{}) {
+	m, _ := metadata.(map[string]interface{})
+	annotations, _ := m["annotations"].(map[string]interface{})
+
+	fieldAnnotations := make(map[string][]interface{})
+	f, _ := annotations["ContentTypeField"].(map[string]interface{})
+	for id, a := range f {
+		fieldAnnotations[id] = convertAnnotationsForReading(a)
+	}
+
+	taxonomy := make([]interface{}, 0)
+	t, _ := m["taxonomy"].([]interface{})
+	for _, iTaxonomy := range t {
+		tax, _ := iTaxonomy.(map[string]interface{})
+		sys, _ := tax["sys"].(map[string]interface{})
+		required, _ := tax["required"].(bool)
+
+		r := map[string]interface{}{"required": required}
+		if sys["linkType"] == "TaxonomyConcept" {
+			r["concept_id"] = sys["id"]
+		} else {
+			r["concept_scheme_id"] = sys["id"]
+		}
+		taxonomy = append(taxonomy, r)
+	}
+
+	return convertAnnotationsForReading(annotations["ContentType"]), taxonomy, fieldAnnotations
+}
+
+func convertAnnotationsForReading(annotations interface{}) []interface{} {
+	a, _ := annotations.([]interface{})
+	result := make([]interface{}, 0, len(a))
+
+	for _, iAnnotation := range a {
+		annotation, _ := iAnnotation.(map[string]interface{})
+		sys, _ := annotation["sys"].(map[string]interface{})
+		r := map[string]interface{}{"id": sys["id"]}
+
+		if annotation["parameters"] != nil {
+			if b, err := json.Marshal(annotation["parameters"]); err == nil {
+				r["parameters"] = string(b)
+			}
+		}
+
+		result = append(result, r)
+	}
+
+	return result
+}
+
+// setFieldAnnotationsForReading sets the annotation blocks of fields read by
+// convertFieldsForReading.
+func setFieldAnnotationsForReading(fields interface{}, fieldAnnotations map[string][]interface{}) {
+	f, _ := fields.([]interface{})
+	for _, iField := range f {
+		field, _ := iField.(map[string]interface{})
+		id, _ := field["id"].(string)
+		if a, ok := fieldAnnotations[id]; ok {
+			field["annotation"] = a
+		}
+	}
+}
+
+func link(linkType string, id interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"type":     "Link",
+		"linkType": linkType,
+		"id":       id,
+	}
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func tagLink(id string) map[string]interface{} {
+	return map[string]interface{}{"sys": link("Tag", id)}
+}
+
+func TestConvertMetadataRenameRoundTrip(t *testing.T) {
+	annotations := []interface{}{map[string]interface{}{"id": "Contentful:AggregateRoot"}}
+	taxonomy := []interface{}{
+		map[string]interface{}{"concept_id": "c1", "required": true},
+		map[string]interface{}{"concept_scheme_id": "s1", "required": false},
+	}
+	fieldAnnotations := []interface{}{map[string]interface{}{"id": "Contentful:GraphQLFieldResolver", "parameters": `{"appFunctionId":"resolve"}`}}
+	fields := []interface{}{
+		map[string]interface{}{"id": "headline", "previous_id": "title", "annotation": fieldAnnotations},
+		map[string]interface{}{"id": "body"},
+	}
+
+	body := make(map[string]interface{})
+	if err := convertMetadataForWriting(body, annotations, taxonomy, fields, map[string]string{"headline": "title"}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// field annotations are sent keyed by the id the field still has
+	metadata := body["metadata"].(map[string]interface{})
+	byField := metadata["annotations"].(map[string]interface{})["ContentTypeField"].(map[string]interface{})
+	if _, ok := byField["title"]; !ok || len(byField) != 1 {
+		t.Fatalf("expected the annotations keyed by title, got %v", byField)
+	}
+
+	// Contentful keys them by the new id once the field is renamed
+	byField["headline"] = byField["title"]
+	delete(byField, "title")
+
+	gotAnnotations, gotTaxonomy, gotFieldAnnotations := convertMetadataForReading(metadata)
+	if !reflect.DeepEqual(gotAnnotations, annotations) {
+		t.Errorf("got annotations %v, want %v", gotAnnotations, annotations)
+	}
+	if !reflect.DeepEqual(gotTaxonomy, taxonomy) {
+		t.Errorf("got taxonomy %v, want %v", gotTaxonomy, taxonomy)
+	}
+	if !reflect.DeepEqual(gotFieldAnnotations, map[string][]interface{}{"headline": fieldAnnotations}) {
+		t.Errorf("got field annotations %v", gotFieldAnnotations)
+	}
+}
+
+func TestConvertMetadataManagedTag(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceContentTypeSchema(), map[string]interface{}{"description": "Posts"})
+	data := &providerData{managedMarker: managedMarkerTag, managedTagID: "managedByTerraform"}
+
+	body := make(map[string]interface{})
+	setManagedDescription(body, d, data)
+
+	annotations := []interface{}{map[string]interface{}{"id": "Contentful:AggregateRoot"}}
+	remote := map[string]interface{}{
+		"tags":        []interface{}{tagLink("editorial"), tagLink("managedByTerraform")},
+		"annotations": map[string]interface{}{"ContentType": []interface{}{map[string]interface{}{"sys": link("Annotation", "Removed")}}},
+	}
+
+	if err := convertMetadataForWriting(body, annotations, nil, nil, nil, remote); err != nil {
+		t.Fatal(err)
+	}
+
+	metadata := body["metadata"].(map[string]interface{})
+
+	// tags set outside of Terraform are kept and the managed tag is not
+	// added twice
+	wantTags := []interface{}{tagLink("editorial"), tagLink("managedByTerraform")}
+	if !reflect.DeepEqual(metadata["tags"], wantTags) {
+		t.Errorf("got tags %v, want %v", metadata["tags"], wantTags)
+	}
+
+	gotAnnotations, _, _ := convertMetadataForReading(metadata)
+	if !reflect.DeepEqual(gotAnnotations, annotations) {
+		t.Errorf("got annotations %v, want %v", gotAnnotations, annotations)
+	}
+	if body["description"] != "Posts" {
+		t.Errorf("got description %v", body["description"])
+	}
+
+	// a content type without tags gets the managed tag
+	body = make(map[string]interface{})
+	setManagedDescription(body, d, data)
+	if err := convertMetadataForWriting(body, nil, nil, nil, nil, map[string]interface{}{}); err != nil {
+		t.Fatal(err)
+	}
+	if tags := body["metadata"].(map[string]interface{})["tags"]; !reflect.DeepEqual(tags, []interface{}{tagLink("managedByTerraform")}) {
+		t.Errorf("got tags %v", tags)
+	}
+}
+
+func TestConvertMetadataKeepsRemoteMetadata(t *testing.T) {
+	remote := map[string]interface{}{
+		"tags":     []interface{}{tagLink("editorial")},
+		"taxonomy": []interface{}{map[string]interface{}{"sys": link("TaxonomyConcept", "c1")}},
+	}
+
+	body := map[string]interface{}{"description": "[DO NOT EDIT: Managed by Terraform] Posts"}
+	if err := convertMetadataForWriting(body, nil, nil, nil, nil, remote); err != nil {
+		t.Fatal(err)
+	}
+
+	// taxonomy is managed by Terraform and removed, tags are not
+	want := map[string]interface{}{"tags": []interface{}{tagLink("editorial")}}
+	if !reflect.DeepEqual(body["metadata"], want) {
+		t.Errorf("got metadata %v, want %v", body["metadata"], want)
+	}
+
+	// removing the last annotation still sends metadata so it is cleared
+	body = make(map[string]interface{})
+	remote = map[string]interface{}{"annotations": map[string]interface{}{"ContentType": []interface{}{}}}
+	if err := convertMetadataForWriting(body, nil, nil, nil, nil, remote); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(body["metadata"], map[string]interface{}{}) {
+		t.Errorf("got metadata %v", body["metadata"])
+	}
+}
@@ -0,0 +1,366 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceContentTypeV0 is the schema of contentful_contenttype before the
+// resolved environment was stored in the resource ID.
+func resourceContentTypeV0() *schema.Resource {
+	validations := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"protected":       {Type: schema.TypeBool, Optional: true},
+			"space_id":        {Type: schema.TypeString, Required: true},
+			"version":         {Type: schema.TypeInt, Computed: true},
+			"name":            {Type: schema.TypeString, Required: true},
+			"description":     {Type: schema.TypeString, Optional: true},
+			"display_field":   {Type: schema.TypeString, Required: true},
+			"content_type_id": {Type: schema.TypeString, Required: true},
+			"env_id":          {Type: schema.TypeString, Optional: true},
+			"field": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":            {Type: schema.TypeString, Required: true},
+						"name":          {Type: schema.TypeString, Required: true},
+						"type":          {Type: schema.TypeString, Required: true},
+						"link_type":     {Type: schema.TypeString, Optional: true},
+						"default_value": {Type: schema.TypeString, Optional: true},
+						"items": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type":        {Type: schema.TypeString, Required: true},
+									"link_type":   {Type: schema.TypeString, Optional: true},
+									"validations": validations,
+								},
+							},
+						},
+						"required":    {Type: schema.TypeBool, Optional: true},
+						"localized":   {Type: schema.TypeBool, Optional: true},
+						"disabled":    {Type: schema.TypeBool, Optional: true},
+						"omitted":     {Type: schema.TypeBool, Optional: true},
+						"validations": validations,
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceContentTypeStateUpgradeV0 rewrites IDs of the form space//id, which
+// were created while relying on the provider env, into space/env/id. The
+// provider env is only needed for those, IDs with an environment are kept.
+func resourceContentTypeStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	id, _ := rawState["id"].(string)
+	ids := strings.Split(id, "/")
+	if len(ids) != 3 {
+		return nil, fmt.Errorf("got invalid id: %s", id)
+	}
+
+	envID := ids[1]
+	if envID == "" {
+		data, ok := meta.(*providerData)
+		if !ok || data == nil || data.client == nil {
+			return nil, fmt.Errorf("unable to resolve environment for id %s: the provider is not configured", id)
+		}
+		envID = data.client.ResolveEnv("")
+	}
+
+	if envID == "" {
+		return nil, fmt.Errorf("unable to resolve environment for id: %s", id)
+	}
+
+	rawState["id"] = fmt.Sprintf("%s/%s/%s", ids[0], envID, ids[2])
+	rawState["env_id"] = envID
+
+	return rawState, nil
+}
+
+// resourceContentTypeV1 is the schema of contentful_contenttype while
+// default_value was a JSON string. Like all upgrade schemas it is a snapshot
+// and must not change with the current schema.
+func resourceContentTypeV1() *schema.Resource {
+	validations := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"protected":       {Type: schema.TypeBool, Optional: true},
+			"space_id":        {Type: schema.TypeString, Required: true},
+			"version":         {Type: schema.TypeInt, Computed: true},
+			"name":            {Type: schema.TypeString, Required: true},
+			"description":     {Type: schema.TypeString, Optional: true},
+			"display_field":   {Type: schema.TypeString, Required: true},
+			"content_type_id": {Type: schema.TypeString, Required: true},
+			"env_id":          {Type: schema.TypeString, Optional: true, Computed: true},
+			"field": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":            {Type: schema.TypeString, Required: true},
+						"name":          {Type: schema.TypeString, Required: true},
+						"type":          {Type: schema.TypeString, Required: true},
+						"link_type":     {Type: schema.TypeString, Optional: true},
+						"default_value": {Type: schema.TypeString, Optional: true},
+						"items": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type":        {Type: schema.TypeString, Required: true},
+									"link_type":   {Type: schema.TypeString, Optional: true},
+									"validation":  validationSchemaV1(),
+									"validations": validations,
+								},
+							},
+						},
+						"required":    {Type: schema.TypeBool, Optional: true},
+						"localized":   {Type: schema.TypeBool, Optional: true},
+						"disabled":    {Type: schema.TypeBool, Optional: true},
+						"omitted":     {Type: schema.TypeBool, Optional: true},
+						"validation":  validationSchemaV1(),
+						"validations": validations,
+					},
+				},
+			},
+		},
+	}
+}
+
+// validationSchemaV1 is the typed validation block of schema versions 1
+// and 2.
+func validationSchemaV1() *schema.Schema {
+	intRange := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"min": {Type: schema.TypeInt, Optional: true},
+				"max": {Type: schema.TypeInt, Optional: true},
+			},
+		},
+	}
+
+	stringRange := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"min": {Type: schema.TypeString, Optional: true},
+				"max": {Type: schema.TypeString, Optional: true},
+			},
+		},
+	}
+
+	regexp := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"pattern": {Type: schema.TypeString, Required: true},
+				"flags":   {Type: schema.TypeString, Optional: true},
+			},
+		},
+	}
+
+	stringList := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"message":             {Type: schema.TypeString, Optional: true},
+				"size":                intRange,
+				"range":               stringRange,
+				"regexp":              regexp,
+				"prohibit_regexp":     regexp,
+				"unique":              {Type: schema.TypeBool, Optional: true},
+				"in":                  stringList,
+				"link_content_type":   stringList,
+				"link_mimetype_group": stringList,
+				"asset_file_size":     intRange,
+				"asset_image_dimensions": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"width":  intRange,
+							"height": intRange,
+						},
+					},
+				},
+				"date_range":         stringRange,
+				"enabled_node_types": stringList,
+				"enabled_marks":      stringList,
+				"nodes": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"node_type":         {Type: schema.TypeString, Required: true},
+							"link_content_type": stringList,
+							"size":              intRange,
+							"message":           {Type: schema.TypeString, Optional: true},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceContentTypeStateUpgradeV1 turns the default_value JSON string of
+// every field into default_value blocks.
+func resourceContentTypeStateUpgradeV1(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	fields, _ := rawState["field"].([]interface{})
+
+	for _, iField := range fields {
+		field, ok := iField.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		raw, _ := field["default_value"].(string)
+		if raw == "" {
+			field["default_value"] = []interface{}{}
+			continue
+		}
+
+		values := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(raw), &values); err != nil {
+			return nil, fmt.Errorf("unable to parse default_value of field %v: %s", field["id"], err.Error())
+		}
+
+		defaultValues, err := convertDefaultValuesForReading(values, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		field["default_value"] = defaultValues
+	}
+
+	return rawState, nil
+}
+
+// resourceContentTypeV2 is the schema of contentful_contenttype before
+// publishing could be turned off and metadata was managed.
+func resourceContentTypeV2() *schema.Resource {
+	validations := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+
+	ids := &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"protected": {Type: schema.TypeBool, Optional: true},
+			"space_id":  {Type: schema.TypeString, Required: true},
+			"version":   {Type: schema.TypeInt, Computed: true},
+			"field_changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"added":        ids,
+						"removed":      ids,
+						"modified":     ids,
+						"reordered":    ids,
+						"type_changed": ids,
+						"renamed":      ids,
+					},
+				},
+			},
+			"published_version":       {Type: schema.TypeInt, Computed: true},
+			"has_unpublished_changes": {Type: schema.TypeBool, Computed: true},
+			"name":                    {Type: schema.TypeString, Required: true},
+			"description":             {Type: schema.TypeString, Optional: true},
+			"display_field":           {Type: schema.TypeString, Required: true},
+			"content_type_id":         {Type: schema.TypeString, Required: true},
+			"env_id":                  {Type: schema.TypeString, Optional: true, Computed: true},
+			"field": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":          {Type: schema.TypeString, Required: true},
+						"previous_id": {Type: schema.TypeString, Optional: true},
+						"name":        {Type: schema.TypeString, Required: true},
+						"type":        {Type: schema.TypeString, Required: true},
+						"link_type":   {Type: schema.TypeString, Optional: true},
+						"default_value": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"locale": {Type: schema.TypeString, Required: true},
+									"value":  {Type: schema.TypeString, Required: true},
+								},
+							},
+						},
+						"items": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type":        {Type: schema.TypeString, Required: true},
+									"link_type":   {Type: schema.TypeString, Optional: true},
+									"validation":  validationSchemaV1(),
+									"validations": validations,
+								},
+							},
+						},
+						"required":    {Type: schema.TypeBool, Optional: true},
+						"localized":   {Type: schema.TypeBool, Optional: true},
+						"disabled":    {Type: schema.TypeBool, Optional: true},
+						"omitted":     {Type: schema.TypeBool, Optional: true},
+						"validation":  validationSchemaV1(),
+						"validations": validations,
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceContentTypeStateUpgradeV2 sets publish to its default, since every
+// content type was published before it existed.
+func resourceContentTypeStateUpgradeV2(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	rawState["publish"] = true
+	return rawState, nil
+}
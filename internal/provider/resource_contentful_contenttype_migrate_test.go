@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/the-urge-tech/terraform-provider-contentful/pkg/contentful"
+)
+
+func TestResourceContentTypeStateUpgradeV0(t *testing.T) {
+	configured := &providerData{client: contentful.NewClient("token", "org", "space", "master")}
+	unconfigured := &providerData{client: contentful.NewClient("token", "org", "space", "")}
+
+	cases := []struct {
+		name    string
+		id      string
+		meta    interface{}
+		want    map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name: "provider env",
+			id:   "space//blog",
+			meta: configured,
+			want: map[string]interface{}{"id": "space/master/blog", "env_id": "master"},
+		},
+		{
+			name: "env in id",
+			id:   "space/staging/blog",
+			meta: configured,
+			want: map[string]interface{}{"id": "space/staging/blog", "env_id": "staging"},
+		},
+		{
+			name: "env in id without meta",
+			id:   "space/staging/blog",
+			meta: nil,
+			want: map[string]interface{}{"id": "space/staging/blog", "env_id": "staging"},
+		},
+		{
+			name:    "no env without meta",
+			id:      "space//blog",
+			meta:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "no env configured",
+			id:      "space//blog",
+			meta:    unconfigured,
+			wantErr: true,
+		},
+		{
+			name:    "invalid id",
+			id:      "blog",
+			meta:    configured,
+			wantErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := resourceContentTypeStateUpgradeV0(context.Background(), map[string]interface{}{"id": c.id}, c.meta)
+			if c.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("got %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestResourceContentTypeStateUpgradeV1(t *testing.T) {
+	rawState := map[string]interface{}{
+		"field": []interface{}{
+			map[string]interface{}{"id": "title", "type": "Symbol", "default_value": `{"en-US":"hello"}`},
+			map[string]interface{}{"id": "count", "type": "Integer", "default_value": `{"en-US":1}`},
+			map[string]interface{}{"id": "body", "type": "Text", "default_value": ""},
+		},
+	}
+
+	got, err := resourceContentTypeStateUpgradeV1(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []interface{}{
+		[]interface{}{map[string]interface{}{"locale": "en-US", "value": "hello"}},
+		[]interface{}{map[string]interface{}{"locale": "en-US", "value": "1"}},
+		[]interface{}{},
+	}
+
+	for i, iField := range got["field"].([]interface{}) {
+		field := iField.(map[string]interface{})
+		if !reflect.DeepEqual(field["default_value"], want[i]) {
+			t.Errorf("field %v: got %v, want %v", field["id"], field["default_value"], want[i])
+		}
+	}
+
+	invalid := map[string]interface{}{
+		"field": []interface{}{map[string]interface{}{"id": "title", "default_value": `"hello"`}},
+	}
+	if _, err := resourceContentTypeStateUpgradeV1(context.Background(), invalid, nil); err == nil {
+		t.Error("expected an error for a default_value that is not a JSON object")
+	}
+}
+
+func TestResourceContentTypeStateUpgradeV2(t *testing.T) {
+	got, err := resourceContentTypeStateUpgradeV2(context.Background(), map[string]interface{}{"id": "space/master/blog"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got["publish"] != true {
+		t.Errorf("got publish %v, want true", got["publish"])
+	}
+}
+
+func TestResourceContentTypeUpgradeSchemas(t *testing.T) {
+	// the upgrade schemas are snapshots, attributes added later must not
+	// appear in them
+	v1 := resourceContentTypeV1().CoreConfigSchema().ImpliedType()
+	for _, name := range []string{"field_changes", "published_version", "publish", "annotation", "taxonomy"} {
+		if v1.HasAttribute(name) {
+			t.Errorf("version 1 schema has %s", name)
+		}
+	}
+
+	v2 := resourceContentTypeV2().CoreConfigSchema().ImpliedType()
+	for _, name := range []string{"publish", "annotation", "taxonomy"} {
+		if v2.HasAttribute(name) {
+			t.Errorf("version 2 schema has %s", name)
+		}
+	}
+
+	field := v2.AttributeType("field").ElementType()
+	for _, name := range []string{"annotation", "appearance", "rich_text"} {
+		if field.HasAttribute(name) {
+			t.Errorf("version 2 field schema has %s", name)
+		}
+	}
+}
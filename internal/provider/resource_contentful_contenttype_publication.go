@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceContentfulContentTypePublication() *schema.Resource {
+	return &schema.Resource{
+		Description: "Publishes a version of a content type, typically one managed by a `contentful_contenttype` with `publish` set to false. Destroying it only removes it from state, the content type stays published.",
+
+		CreateContext: resourceContentTypePublicationCreate,
+		ReadContext:   resourceContentTypePublicationRead,
+		UpdateContext: resourceContentTypePublicationUpdate,
+		DeleteContext: resourceContentTypePublicationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"env_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The environment id. Defaults to the provider `env`.",
+			},
+			"content_type_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version": {
+				Type:             schema.TypeInt,
+				Required:         true,
+				DiffSuppressFunc: publishedVersionDiff,
+				Description:      "The version to publish, usually the `version` of the `contentful_contenttype`",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// publishedVersionDiff suppresses the diff between a published version and the
+// version that follows it. Publishing bumps the version of a content type
+// without changing it, so that version is still the published one.
+func publishedVersionDiff(k, old, new string, d *schema.ResourceData) bool {
+	o, err := strconv.Atoi(old)
+	if err != nil {
+		return false
+	}
+
+	n, err := strconv.Atoi(new)
+	if err != nil {
+		return false
+	}
+
+	return n == o+1
+}
+
+func resourceContentTypePublicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerData).client
+
+	spaceID := d.Get("space_id").(string)
+	envID := client.ResolveEnv(d.Get("env_id").(string))
+	id := d.Get("content_type_id").(string)
+
+	if envID == "" {
+		return diag.Errorf("env_id must be set when the provider env is not configured")
+	}
+
+	res, err := client.Env(envID).ContentType.Activate(ctx, spaceID, envID, id, d.Get("version").(int))
+	if err != nil {
+		return diag.Errorf("Unknown error when activating content type: %s", err.Error())
+	}
+
+	d.Set("env_id", envID)
+	d.Set("version", getPublishedVersion(res))
+	d.SetId(fmt.Sprintf("%s/%s/%s", spaceID, envID, id))
+
+	return nil
+}
+
+func resourceContentTypePublicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids := strings.Split(d.Id(), "/")
+	if len(ids) != 3 {
+		return diag.Errorf("Got invalid id: %s", d.Id())
+	}
+	spaceID := ids[0]
+	envID := ids[1]
+	id := ids[2]
+
+	client := meta.(*providerData).client.Env(envID)
+
+	ct, err := client.ContentType.Read(ctx, spaceID, envID, id)
+
+	if err != nil && strings.Contains(err.Error(), "status code 404") {
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return diag.Errorf("Unknown error when getting content type with id:%s : %s", d.Id(), err.Error())
+	}
+
+	// unpublished outside of Terraform, publish it again
+	publishedVersion := getPublishedVersion(ct)
+	if publishedVersion == 0 {
+		d.SetId("")
+		return diags
+	}
+
+	d.Set("space_id", spaceID)
+	d.Set("env_id", envID)
+	d.Set("content_type_id", id)
+	d.Set("version", publishedVersion)
+
+	return diags
+}
+
+func resourceContentTypePublicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids := strings.Split(d.Id(), "/")
+	if len(ids) != 3 {
+		return diag.Errorf("Got invalid id: %s", d.Id())
+	}
+	spaceID := ids[0]
+	envID := ids[1]
+	id := ids[2]
+
+	client := meta.(*providerData).client.Env(envID)
+
+	res, err := client.ContentType.Activate(ctx, spaceID, envID, id, d.Get("version").(int))
+	if err != nil {
+		return diag.Errorf("Unknown error when activating content type: %s", err.Error())
+	}
+
+	d.Set("version", getPublishedVersion(res))
+
+	return diags
+}
+
+func resourceContentTypePublicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
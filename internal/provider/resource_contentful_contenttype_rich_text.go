@@ -0,0 +1,233 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// richTextNodes maps the rich_text node blocks to the Contentful node types
+// they configure, and whether the node links to entries.
+var richTextNodes = map[string]struct {
+	nodeType     string
+	linksEntries bool
+}{
+	"embedded_entries": {nodeType: "embedded-entry-block", linksEntries: true},
+	"inline_entries":   {nodeType: "embedded-entry-inline", linksEntries: true},
+	"entry_hyperlinks": {nodeType: "entry-hyperlink", linksEntries: true},
+	"embedded_assets":  {nodeType: "embedded-asset-block"},
+	"asset_hyperlinks": {nodeType: "asset-hyperlink"},
+}
+
+func richTextNodeSchema(description string, linksEntries bool) *schema.Schema {
+	s := map[string]*schema.Schema{
+		"min": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Minimum number of nodes, 0 means unbounded",
+		},
+		"max": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Maximum number of nodes, 0 means unbounded",
+		},
+		"message": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Custom error message shown when the node validations fail",
+		},
+	}
+
+	if linksEntries {
+		s["link_content_types"] = &schema.Schema{
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Content type ids the linked entries may have",
+		}
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem:        &schema.Resource{Schema: s},
+	}
+}
+
+func richTextSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration of a RichText field, translated into its `enabledMarks`, `enabledNodeTypes` and `nodes` validations",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled_marks": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Allowed marks, e.g. `bold`, `italic`, `underline` or `code`",
+				},
+				"enabled_marks_message": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"enabled_node_types": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Allowed node types, e.g. `heading-1`, `ordered-list` or `embedded-entry-block`",
+				},
+				"enabled_node_types_message": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"embedded_entries": richTextNodeSchema("Constraints on embedded entry blocks", true),
+				"inline_entries":   richTextNodeSchema("Constraints on inline entries", true),
+				"entry_hyperlinks": richTextNodeSchema("Constraints on hyperlinks to entries", true),
+				"embedded_assets":  richTextNodeSchema("Constraints on embedded asset blocks", false),
+				"asset_hyperlinks": richTextNodeSchema("Constraints on hyperlinks to assets", false),
+			},
+		},
+	}
+}
+
+// convertRichTextForWriting translates a rich_text block into Contentful
+// validations.
+func convertRichTextForWriting(richText map[string]interface{}) []interface{} {
+	result := make([]interface{}, 0, 3)
+
+	if marks := stringList(richText["enabled_marks"]); len(marks) > 0 {
+		message, _ := richText["enabled_marks_message"].(string)
+		result = append(result, withMessage(map[string]interface{}{"enabledMarks": marks}, message))
+	}
+
+	if nodeTypes := stringList(richText["enabled_node_types"]); len(nodeTypes) > 0 {
+		message, _ := richText["enabled_node_types_message"].(string)
+		result = append(result, withMessage(map[string]interface{}{"enabledNodeTypes": nodeTypes}, message))
+	}
+
+	nodes := make(map[string]interface{})
+	for k, n := range richTextNodes {
+		node := singleBlock(richText[k])
+		if node == nil {
+			continue
+		}
+
+		message, _ := node["message"].(string)
+		nodeValidations := make([]interface{}, 0, 2)
+
+		if contentTypes := stringList(node["link_content_types"]); len(contentTypes) > 0 {
+			nodeValidations = append(nodeValidations, withMessage(map[string]interface{}{"linkContentType": contentTypes}, message))
+		}
+
+		if size := intRangeForWriting(node); len(size) > 0 {
+			nodeValidations = append(nodeValidations, withMessage(map[string]interface{}{"size": size}, message))
+		}
+
+		nodes[n.nodeType] = nodeValidations
+	}
+
+	if len(nodes) > 0 {
+		result = append(result, map[string]interface{}{"nodes": nodes})
+	}
+
+	return result
+}
+
+// extractRichTextForReading picks the enabledMarks, enabledNodeTypes and
+// nodes validations out of validations and translates them into a rich_text
+// block. It returns false, leaving validations untouched, when there are none
+// or they cannot be expressed by a rich_text block.
+func extractRichTextForReading(validations []interface{}) (map[string]interface{}, []interface{}, bool) {
+	richText := make(map[string]interface{})
+	picked := make([]interface{}, 0, 3)
+	rest := make([]interface{}, 0, len(validations))
+
+	for _, iValidation := range validations {
+		validation, ok := iValidation.(map[string]interface{})
+		if !ok {
+			rest = append(rest, iValidation)
+			continue
+		}
+
+		message, _ := validation["message"].(string)
+
+		switch {
+		case validation["enabledMarks"] != nil && richText["enabled_marks"] == nil:
+			richText["enabled_marks"] = validation["enabledMarks"]
+			richText["enabled_marks_message"] = message
+		case validation["enabledNodeTypes"] != nil && richText["enabled_node_types"] == nil:
+			richText["enabled_node_types"] = validation["enabledNodeTypes"]
+			richText["enabled_node_types_message"] = message
+		case validation["nodes"] != nil && !hasRichTextNodes(richText):
+			nodes, _ := validation["nodes"].(map[string]interface{})
+			if !readRichTextNodes(richText, nodes) {
+				return nil, validations, false
+			}
+			// an empty nodes validation means the same as none at all
+			if len(nodes) == 0 {
+				continue
+			}
+		default:
+			rest = append(rest, iValidation)
+			continue
+		}
+
+		picked = append(picked, validation)
+	}
+
+	if len(picked) == 0 {
+		return nil, validations, false
+	}
+
+	if !validationSetsEqual(convertRichTextForWriting(richText), picked) {
+		return nil, validations, false
+	}
+
+	return richText, rest, true
+}
+
+func hasRichTextNodes(richText map[string]interface{}) bool {
+	for k := range richTextNodes {
+		if richText[k] != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func readRichTextNodes(richText map[string]interface{}, nodes map[string]interface{}) bool {
+	keys := make(map[string]string, len(richTextNodes))
+	for k, n := range richTextNodes {
+		keys[n.nodeType] = k
+	}
+
+	for nodeType, iNodeValidations := range nodes {
+		key, ok := keys[nodeType]
+		if !ok {
+			return false
+		}
+
+		node := make(map[string]interface{})
+		nodeValidations, _ := iNodeValidations.([]interface{})
+		for _, iNodeValidation := range nodeValidations {
+			nodeValidation, _ := iNodeValidation.(map[string]interface{})
+			if nodeValidation["linkContentType"] != nil {
+				node["link_content_types"] = nodeValidation["linkContentType"]
+			}
+			if nodeValidation["size"] != nil {
+				for k, v := range singleBlock(intRangeForReading(nodeValidation["size"])) {
+					node[k] = v
+				}
+			}
+			if nodeValidation["message"] != nil {
+				node["message"] = nodeValidation["message"]
+			}
+		}
+
+		richText[key] = []interface{}{node}
+	}
+
+	return true
+}
@@ -0,0 +1,353 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/the-urge-tech/terraform-provider-contentful/pkg/contentful"
+)
+
+// unknownValue is how the SDK represents values not known until apply in a
+// raw config.
+const unknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+const richTextFieldJSON = `[{
+	"id": "body",
+	"name": "Body",
+	"type": "RichText",
+	"localized": false,
+	"required": true,
+	"disabled": false,
+	"omitted": false,
+	"validations": [
+		{"enabledMarks": ["bold", "italic"], "message": "marks"},
+		{"enabledNodeTypes": ["heading-1", "embedded-entry-block"]},
+		{"nodes": {"embedded-entry-block": [{"linkContentType": ["author"]}, {"size": {"max": 2}}]}},
+		{"size": {"max": 1000}}
+	]
+}]`
+
+func decodeFields(t *testing.T, s string) []interface{} {
+	t.Helper()
+
+	var fields []interface{}
+	if err := json.Unmarshal([]byte(s), &fields); err != nil {
+		t.Fatal(err)
+	}
+	return fields
+}
+
+func TestConvertFieldsRichTextRoundTrip(t *testing.T) {
+	fields := decodeFields(t, richTextFieldJSON)
+	original := decodeFields(t, richTextFieldJSON)[0].(map[string]interface{})
+
+	if err := convertFieldsForReading(fields, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	field := fields[0].(map[string]interface{})
+	richText := singleBlock(field["rich_text"])
+	if richText == nil {
+		t.Fatalf("expected a rich_text block, got %v", field)
+	}
+	if len(stringList(richText["enabled_marks"])) != 2 || richText["enabled_marks_message"] != "marks" {
+		t.Errorf("got rich_text %v", richText)
+	}
+	if typed, _ := field["validation"].([]interface{}); len(typed) != 1 {
+		t.Errorf("expected the size validation as a typed block, got %v", field["validation"])
+	}
+
+	// the fields read have to fit the schema, as imports and generate set them
+	d := schema.TestResourceDataRaw(t, resourceContentTypeSchema(), map[string]interface{}{})
+	if err := d.Set("field", fields); err != nil {
+		t.Fatal(err)
+	}
+
+	written, err := convertFieldsForWriting(d.Get("field"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	writtenField := written.([]interface{})[0].(map[string]interface{})
+	if writtenField["id"] != "body" || writtenField["type"] != "RichText" || writtenField["required"] != true {
+		t.Errorf("got field %v", writtenField)
+	}
+	if !validationSetsEqual(writtenField["validations"], original["validations"]) {
+		t.Errorf("round trip changed the validations: got %v, want %v", writtenField["validations"], original["validations"])
+	}
+}
+
+func TestConvertFieldsRichTextKeepsJSON(t *testing.T) {
+	// fields in state configured with JSON validations keep them as JSON
+	current := []interface{}{
+		map[string]interface{}{"id": "body", "type": "RichText", "rich_text": []interface{}{}},
+	}
+
+	fields := decodeFields(t, richTextFieldJSON)
+	if err := convertFieldsForReading(fields, current); err != nil {
+		t.Fatal(err)
+	}
+
+	field := fields[0].(map[string]interface{})
+	if field["rich_text"] != nil {
+		t.Errorf("expected no rich_text block, got %v", field["rich_text"])
+	}
+
+	typed, _ := field["validation"].([]interface{})
+	raw, _ := field["validations"].([]interface{})
+	if len(typed)+len(raw) != 4 {
+		t.Errorf("expected all 4 validations, got typed %v and JSON %v", typed, raw)
+	}
+}
+
+func TestConvertFieldsForWritingRichText(t *testing.T) {
+	fields := []interface{}{
+		map[string]interface{}{
+			"id":   "body",
+			"name": "Body",
+			"type": "RichText",
+			"rich_text": []interface{}{map[string]interface{}{
+				"enabled_marks":      []interface{}{"bold"},
+				"enabled_node_types": []interface{}{"entry-hyperlink"},
+				"entry_hyperlinks": []interface{}{map[string]interface{}{
+					"link_content_types": []interface{}{"page"},
+					"max":                0,
+					"min":                0,
+					"message":            "",
+				}},
+			}},
+			"validations": []interface{}{`{"unique":true}`},
+		},
+	}
+
+	written, err := convertFieldsForWriting(fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	field := written.([]interface{})[0].(map[string]interface{})
+	if _, ok := field["rich_text"]; ok {
+		t.Error("rich_text is sent to Contentful")
+	}
+
+	want := []interface{}{
+		`{"unique":true}`,
+		`{"enabledMarks":["bold"]}`,
+		`{"enabledNodeTypes":["entry-hyperlink"]}`,
+		`{"nodes":{"entry-hyperlink":[{"linkContentType":["page"]}]}}`,
+	}
+	if !validationSetsEqual(field["validations"], want) {
+		t.Errorf("got validations %v, want %v", field["validations"], want)
+	}
+}
+
+func TestGetRenamedAndDeletedFieldIDs(t *testing.T) {
+	field := func(id string, previousID string) interface{} {
+		return map[string]interface{}{"id": id, "previous_id": previousID}
+	}
+
+	cases := []struct {
+		name        string
+		old         []interface{}
+		new         []interface{}
+		wantRenamed map[string]string
+		wantDeleted map[string]bool
+	}{
+		{
+			name:        "unchanged",
+			old:         []interface{}{field("title", ""), field("body", "")},
+			new:         []interface{}{field("title", ""), field("body", "")},
+			wantRenamed: map[string]string{},
+			wantDeleted: map[string]bool{},
+		},
+		{
+			name:        "renamed",
+			old:         []interface{}{field("title", ""), field("body", "")},
+			new:         []interface{}{field("headline", "title"), field("body", "")},
+			wantRenamed: map[string]string{"headline": "title"},
+			wantDeleted: map[string]bool{},
+		},
+		{
+			name:        "renamed and applied",
+			old:         []interface{}{field("headline", "title")},
+			new:         []interface{}{field("headline", "title")},
+			wantRenamed: map[string]string{},
+			wantDeleted: map[string]bool{},
+		},
+		{
+			name:        "id changed without previous_id",
+			old:         []interface{}{field("title", "")},
+			new:         []interface{}{field("headline", "")},
+			wantRenamed: map[string]string{},
+			wantDeleted: map[string]bool{"title": true},
+		},
+		{
+			name:        "previous_id of an unknown field",
+			old:         []interface{}{field("title", "")},
+			new:         []interface{}{field("headline", "subtitle")},
+			wantRenamed: map[string]string{},
+			wantDeleted: map[string]bool{"title": true},
+		},
+		{
+			name:        "removed",
+			old:         []interface{}{field("title", ""), field("body", "")},
+			new:         []interface{}{field("title", "")},
+			wantRenamed: map[string]string{},
+			wantDeleted: map[string]bool{"body": true},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := getRenamedFieldIDs(c.old, c.new); !reflect.DeepEqual(got, c.wantRenamed) {
+				t.Errorf("got renamed %v, want %v", got, c.wantRenamed)
+			}
+			if got := getDeletedFieldIDs(c.old, c.new); !reflect.DeepEqual(got, c.wantDeleted) {
+				t.Errorf("got deleted %v, want %v", got, c.wantDeleted)
+			}
+		})
+	}
+}
+
+func TestConvertFieldsForReadingKeepsPreviousID(t *testing.T) {
+	fields := decodeFields(t, `[{"id": "headline", "name": "Headline", "type": "Symbol"}]`)
+	current := []interface{}{
+		map[string]interface{}{"id": "headline", "previous_id": "title"},
+	}
+
+	if err := convertFieldsForReading(fields, current); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := fields[0].(map[string]interface{})["previous_id"]; got != "title" {
+		t.Errorf("got previous_id %v, want title", got)
+	}
+
+	written, err := convertFieldsForWriting(fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := written.([]interface{})[0].(map[string]interface{})["previous_id"]; ok {
+		t.Error("previous_id is sent to Contentful")
+	}
+}
+
+func TestValidateFieldTypes(t *testing.T) {
+	meta := &providerData{client: contentful.NewClient("token", "org", "space", "master")}
+
+	raw := func(field map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"space_id":        "space",
+			"env_id":          "master",
+			"content_type_id": "blog",
+			"name":            "Blog",
+			"display_field":   "title",
+			"field":           []interface{}{field},
+		}
+	}
+
+	// the raw config is only available in plans made by Terraform, so diff
+	// against an existing content type
+	state := schema.TestResourceDataRaw(t, resourceContentTypeSchema(), raw(map[string]interface{}{"id": "title", "name": "Title", "type": "Symbol"}))
+	state.SetId("space/master/blog")
+
+	cases := []struct {
+		name    string
+		field   map[string]interface{}
+		wantErr string
+	}{
+		{
+			name:    "invalid type",
+			field:   map[string]interface{}{"id": "title", "name": "Title", "type": "String"},
+			wantErr: "expected type to be one of [Symbol Text",
+		},
+		{
+			name:    "link without link_type",
+			field:   map[string]interface{}{"id": "title", "name": "Title", "type": "Link"},
+			wantErr: "link_type is required when type is Link",
+		},
+		{
+			name:    "link_type on a Symbol",
+			field:   map[string]interface{}{"id": "title", "name": "Title", "type": "Symbol", "link_type": "Entry"},
+			wantErr: "link_type can only be set when type is Link",
+		},
+		{
+			name:    "array without items",
+			field:   map[string]interface{}{"id": "title", "name": "Title", "type": "Array"},
+			wantErr: "items is required when type is Array",
+		},
+		{
+			name: "array with an invalid items type",
+			field: map[string]interface{}{"id": "title", "name": "Title", "type": "Array",
+				"items": []interface{}{map[string]interface{}{"type": "Integer"}}},
+			wantErr: "expected type to be one of [Symbol Link ResourceLink]",
+		},
+		{
+			name: "array of links without link_type",
+			field: map[string]interface{}{"id": "title", "name": "Title", "type": "Array",
+				"items": []interface{}{map[string]interface{}{"type": "Link"}}},
+			wantErr: "items.link_type is required when items.type is Link",
+		},
+		{
+			name: "items on a Symbol",
+			field: map[string]interface{}{"id": "title", "name": "Title", "type": "Symbol",
+				"items": []interface{}{map[string]interface{}{"type": "Symbol"}}},
+			wantErr: "items can only be set when type is Array",
+		},
+		{
+			name: "default value of the wrong type",
+			field: map[string]interface{}{"id": "title", "name": "Title", "type": "Integer",
+				"default_value": []interface{}{map[string]interface{}{"locale": "en-US", "value": "one"}}},
+			wantErr: "default_value for locale en-US",
+		},
+		{
+			name:  "unknown type",
+			field: map[string]interface{}{"id": "title", "name": "Title", "type": unknownValue, "link_type": "Entry"},
+		},
+		{
+			name: "unknown items type",
+			field: map[string]interface{}{"id": "title", "name": "Title", "type": "Array",
+				"items": []interface{}{map[string]interface{}{"type": unknownValue}}},
+		},
+		{
+			name: "array of links",
+			field: map[string]interface{}{"id": "title", "name": "Title", "type": "Array",
+				"items": []interface{}{map[string]interface{}{"type": "Link", "link_type": "Entry"}}},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(raw(c.field))
+
+			// schema validation runs before the diff in Terraform
+			diags := resourceContentfulContentType().Validate(config)
+			if !diags.HasError() {
+				_, err := resourceContentfulContentType().Diff(context.Background(), state.State(), config, meta)
+				if err != nil {
+					diags = append(diags, diag.FromErr(err)...)
+				}
+			}
+
+			if c.wantErr == "" {
+				if diags.HasError() {
+					t.Errorf("expected the plan to succeed, got %v", diags)
+				}
+				return
+			}
+
+			for _, d := range diags {
+				if strings.Contains(d.Summary, c.wantErr) {
+					return
+				}
+			}
+			t.Errorf("expected an error containing %q, got %v", c.wantErr, diags)
+		})
+	}
+}
@@ -0,0 +1,639 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// validationKinds maps the typed validation attributes to the keys used by
+// the Contentful API. Every validation block sets exactly one of them.
+var validationKinds = map[string]string{
+	"size":                   "size",
+	"range":                  "range",
+	"regexp":                 "regexp",
+	"prohibit_regexp":        "prohibitRegexp",
+	"unique":                 "unique",
+	"in":                     "in",
+	"link_content_type":      "linkContentType",
+	"link_mimetype_group":    "linkMimetypeGroup",
+	"asset_file_size":        "assetFileSize",
+	"asset_image_dimensions": "assetImageDimensions",
+	"date_range":             "dateRange",
+	"enabled_node_types":     "enabledNodeTypes",
+	"enabled_marks":          "enabledMarks",
+	"nodes":                  "nodes",
+}
+
+func intRangeSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"min": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "Lower bound, 0 means unbounded",
+				},
+				"max": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "Upper bound, 0 means unbounded",
+				},
+			},
+		},
+	}
+}
+
+func stringRangeSchema(description string, validate schema.SchemaValidateFunc) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"min": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validate),
+				},
+				"max": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validate),
+				},
+			},
+		},
+	}
+}
+
+func regexpSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"pattern": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"flags": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func stringListSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: description,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+func isNumber(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if _, err := strconv.ParseFloat(v, 64); v != "" && err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be a number, got %s", k, v)}
+	}
+
+	return nil, nil
+}
+
+func validationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "A typed validation. Each block sets exactly one kind of validation and an optional message.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"message": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Custom error message shown when the validation fails",
+				},
+				"size":            intRangeSchema("Length of a Symbol or Text field, or number of items of an Array field"),
+				"range":           stringRangeSchema("Value range of an Integer or Number field", isNumber),
+				"regexp":          regexpSchema("Pattern a Symbol or Text field has to match"),
+				"prohibit_regexp": regexpSchema("Pattern a Symbol or Text field must not match"),
+				"unique": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Whether the value has to be unique across entries",
+				},
+				"in":                  stringListSchema("Allowed values. Values of Integer and Number fields are converted to numbers"),
+				"link_content_type":   stringListSchema("Content type ids a Link to an Entry may point to"),
+				"link_mimetype_group": stringListSchema("Mimetype groups a Link to an Asset may point to"),
+				"asset_file_size":     intRangeSchema("File size in bytes of a linked Asset"),
+				"asset_image_dimensions": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Image dimensions in pixels of a linked Asset",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"width":  intRangeSchema("Image width"),
+							"height": intRangeSchema("Image height"),
+						},
+					},
+				},
+				"date_range":         stringRangeSchema("Date range of a Date field", validation.StringIsNotWhiteSpace),
+				"enabled_node_types": stringListSchema("Node types allowed in a RichText field"),
+				"enabled_marks":      stringListSchema("Marks allowed in a RichText field"),
+				"nodes": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Validations of embedded and linked nodes in a RichText field",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"node_type": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"link_content_type": stringListSchema("Content type ids the node may link to"),
+							"size":              intRangeSchema("Number of nodes of this type"),
+							"message": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func singleBlock(v interface{}) map[string]interface{} {
+	l, ok := v.([]interface{})
+	if !ok || len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	return l[0].(map[string]interface{})
+}
+
+func stringList(v interface{}) []interface{} {
+	l, _ := v.([]interface{})
+	result := make([]interface{}, 0, len(l))
+	for _, e := range l {
+		s, _ := e.(string)
+		result = append(result, s)
+	}
+	return result
+}
+
+func intRangeForWriting(m map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	if v, _ := m["min"].(int); v != 0 {
+		result["min"] = v
+	}
+	if v, _ := m["max"].(int); v != 0 {
+		result["max"] = v
+	}
+	return result
+}
+
+func intRangeForReading(v interface{}) []interface{} {
+	m, _ := v.(map[string]interface{})
+	result := make(map[string]interface{})
+	if f, ok := m["min"].(float64); ok {
+		result["min"] = int(f)
+	}
+	if f, ok := m["max"].(float64); ok {
+		result["max"] = int(f)
+	}
+	return []interface{}{result}
+}
+
+func stringRangeForWriting(m map[string]interface{}, numeric bool) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	for _, k := range []string{"min", "max"} {
+		v, _ := m[k].(string)
+		if v == "" {
+			continue
+		}
+
+		if !numeric {
+			result[k] = v
+			continue
+		}
+
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, fmt.Errorf("expected range %s to be a number, got %s", k, v)
+		}
+		result[k] = f
+	}
+	return result, nil
+}
+
+func stringRangeForReading(v interface{}) []interface{} {
+	m, _ := v.(map[string]interface{})
+	result := make(map[string]interface{})
+	for _, k := range []string{"min", "max"} {
+		switch t := m[k].(type) {
+		case string:
+			result[k] = t
+		case float64:
+			result[k] = strconv.FormatFloat(t, 'f', -1, 64)
+		}
+	}
+	return []interface{}{result}
+}
+
+func regexpForWriting(m map[string]interface{}) map[string]interface{} {
+	result := map[string]interface{}{"pattern": m["pattern"]}
+	if v, _ := m["flags"].(string); v != "" {
+		result["flags"] = v
+	}
+	return result
+}
+
+func regexpForReading(v interface{}) []interface{} {
+	m, _ := v.(map[string]interface{})
+	result := map[string]interface{}{"pattern": m["pattern"]}
+	if v, ok := m["flags"].(string); ok {
+		result["flags"] = v
+	}
+	return []interface{}{result}
+}
+
+// keepNumericInValues replaces the in values of typed validations read from
+// Contentful with the ones in current, the typed validations in state, that
+// are the same number. Contentful returns 1.50 as 1.5, which would otherwise
+// show up as a change in every plan.
+func keepNumericInValues(typed []interface{}, current interface{}) {
+	inState := make(map[float64]string)
+	c, _ := current.([]interface{})
+	for _, iValidation := range c {
+		validation, _ := iValidation.(map[string]interface{})
+		for _, s := range stringList(validation["in"]) {
+			if f, err := strconv.ParseFloat(s.(string), 64); err == nil {
+				inState[f] = s.(string)
+			}
+		}
+	}
+
+	for _, iValidation := range typed {
+		validation, _ := iValidation.(map[string]interface{})
+		values, _ := validation["in"].([]interface{})
+		for i, v := range values {
+			s, _ := v.(string)
+			if f, err := strconv.ParseFloat(s, 64); err == nil {
+				if stateValue, ok := inState[f]; ok {
+					values[i] = stateValue
+				}
+			}
+		}
+	}
+}
+
+func isNumericFieldType(fieldType string) bool {
+	return fieldType == "Integer" || fieldType == "Number"
+}
+
+// countValidationKinds returns the typed validation kinds set in v.
+func countValidationKinds(v map[string]interface{}) []string {
+	kinds := make([]string, 0, 1)
+	for k := range validationKinds {
+		switch t := v[k].(type) {
+		case bool:
+			if t {
+				kinds = append(kinds, k)
+			}
+		case []interface{}:
+			if len(t) > 0 {
+				kinds = append(kinds, k)
+			}
+		}
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
+// convertValidationForWriting converts a typed validation block into the
+// Contentful validation object. fieldType is the type of the field (or of its
+// items) the validation belongs to.
+func convertValidationForWriting(v map[string]interface{}, fieldType string) (map[string]interface{}, error) {
+	kinds := countValidationKinds(v)
+	if len(kinds) != 1 {
+		return nil, fmt.Errorf("a validation block must set exactly one of size, range, regexp, prohibit_regexp, unique, in, link_content_type, link_mimetype_group, asset_file_size, asset_image_dimensions, date_range, enabled_node_types, enabled_marks or nodes, got %v", kinds)
+	}
+
+	result := make(map[string]interface{})
+	kind := kinds[0]
+
+	switch kind {
+	case "size", "asset_file_size":
+		result[validationKinds[kind]] = intRangeForWriting(singleBlock(v[kind]))
+	case "range", "date_range":
+		r, err := stringRangeForWriting(singleBlock(v[kind]), kind == "range")
+		if err != nil {
+			return nil, err
+		}
+		result[validationKinds[kind]] = r
+	case "regexp", "prohibit_regexp":
+		result[validationKinds[kind]] = regexpForWriting(singleBlock(v[kind]))
+	case "unique":
+		result["unique"] = true
+	case "in":
+		values := stringList(v["in"])
+		if isNumericFieldType(fieldType) {
+			for i, s := range values {
+				f, err := strconv.ParseFloat(s.(string), 64)
+				if err != nil {
+					return nil, fmt.Errorf("expected value %q of in to be a number for a %s field", s, fieldType)
+				}
+				values[i] = f
+			}
+		}
+		result["in"] = values
+	case "link_content_type", "link_mimetype_group", "enabled_node_types", "enabled_marks":
+		result[validationKinds[kind]] = stringList(v[kind])
+	case "asset_image_dimensions":
+		dimensions := make(map[string]interface{})
+		if width := singleBlock(singleBlock(v[kind])["width"]); width != nil {
+			dimensions["width"] = intRangeForWriting(width)
+		}
+		if height := singleBlock(singleBlock(v[kind])["height"]); height != nil {
+			dimensions["height"] = intRangeForWriting(height)
+		}
+		result["assetImageDimensions"] = dimensions
+	case "nodes":
+		nodes := make(map[string]interface{})
+		for _, n := range v["nodes"].([]interface{}) {
+			node := n.(map[string]interface{})
+			message, _ := node["message"].(string)
+			nodeValidations := make([]interface{}, 0)
+
+			if contentTypes := stringList(node["link_content_type"]); len(contentTypes) > 0 {
+				nodeValidations = append(nodeValidations, withMessage(map[string]interface{}{"linkContentType": contentTypes}, message))
+			}
+			if size := singleBlock(node["size"]); size != nil {
+				nodeValidations = append(nodeValidations, withMessage(map[string]interface{}{"size": intRangeForWriting(size)}, message))
+			}
+
+			nodes[node["node_type"].(string)] = nodeValidations
+		}
+		result["nodes"] = nodes
+	}
+
+	if message, _ := v["message"].(string); message != "" {
+		result["message"] = message
+	}
+
+	return result, nil
+}
+
+func withMessage(m map[string]interface{}, message string) map[string]interface{} {
+	if message != "" {
+		m["message"] = message
+	}
+	return m
+}
+
+// convertValidationForReading converts a Contentful validation object into a
+// typed validation block. It returns false when the validation cannot be
+// expressed as a typed block without losing information, in which case it
+// should be kept in its JSON form.
+func convertValidationForReading(v map[string]interface{}, fieldType string) (map[string]interface{}, bool) {
+	result := make(map[string]interface{})
+
+	for k, apiKey := range validationKinds {
+		value, ok := v[apiKey]
+		if !ok {
+			continue
+		}
+
+		switch k {
+		case "size", "asset_file_size":
+			result[k] = intRangeForReading(value)
+		case "range", "date_range":
+			result[k] = stringRangeForReading(value)
+		case "regexp", "prohibit_regexp":
+			result[k] = regexpForReading(value)
+		case "unique":
+			b, _ := value.(bool)
+			result[k] = b
+		case "in":
+			values, _ := value.([]interface{})
+			in := make([]interface{}, 0, len(values))
+			for _, e := range values {
+				switch t := e.(type) {
+				case string:
+					in = append(in, t)
+				case float64:
+					in = append(in, strconv.FormatFloat(t, 'f', -1, 64))
+				default:
+					return nil, false
+				}
+			}
+			result[k] = in
+		case "link_content_type", "link_mimetype_group", "enabled_node_types", "enabled_marks":
+			result[k] = value
+		case "asset_image_dimensions":
+			m, _ := value.(map[string]interface{})
+			dimensions := make(map[string]interface{})
+			if m["width"] != nil {
+				dimensions["width"] = intRangeForReading(m["width"])
+			}
+			if m["height"] != nil {
+				dimensions["height"] = intRangeForReading(m["height"])
+			}
+			result[k] = []interface{}{dimensions}
+		case "nodes":
+			m, _ := value.(map[string]interface{})
+			nodeTypes := make([]string, 0, len(m))
+			for nodeType := range m {
+				nodeTypes = append(nodeTypes, nodeType)
+			}
+			sort.Strings(nodeTypes)
+
+			nodes := make([]interface{}, 0, len(nodeTypes))
+			for _, nodeType := range nodeTypes {
+				node := map[string]interface{}{"node_type": nodeType}
+				nodeValidations, _ := m[nodeType].([]interface{})
+				for _, nv := range nodeValidations {
+					nvMap, _ := nv.(map[string]interface{})
+					if nvMap["linkContentType"] != nil {
+						node["link_content_type"] = nvMap["linkContentType"]
+					}
+					if nvMap["size"] != nil {
+						node["size"] = intRangeForReading(nvMap["size"])
+					}
+					if nvMap["message"] != nil {
+						node["message"] = nvMap["message"]
+					}
+				}
+				nodes = append(nodes, node)
+			}
+			result[k] = nodes
+		}
+	}
+
+	if message, ok := v["message"].(string); ok {
+		result["message"] = message
+	}
+
+	// only accept the typed form when it converts back to the same object
+	written, err := convertValidationForWriting(result, fieldType)
+	if err != nil || !jsonEqual(written, v) {
+		return nil, false
+	}
+
+	return result, true
+}
+
+func jsonEqual(a, b interface{}) bool {
+	aBytes, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	bBytes, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+
+	var aValue, bValue interface{}
+	if json.Unmarshal(aBytes, &aValue) != nil || json.Unmarshal(bBytes, &bValue) != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(aValue, bValue)
+}
+
+// unorderedValidationKeys are the validation keys whose array values have no
+// meaningful order.
+var unorderedValidationKeys = map[string]bool{
+	"in":                true,
+	"linkContentType":   true,
+	"linkMimetypeGroup": true,
+	"enabledNodeTypes":  true,
+	"enabledMarks":      true,
+}
+
+// canonicalValidationJSON returns the canonical JSON of a validation given
+// either as a JSON string or as a decoded object: keys sorted, numbers
+// normalized and unordered arrays sorted.
+func canonicalValidationJSON(v interface{}) (string, error) {
+	var value interface{}
+
+	if s, ok := v.(string); ok {
+		if err := json.Unmarshal([]byte(s), &value); err != nil {
+			return "", err
+		}
+	} else {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		if err := json.Unmarshal(b, &value); err != nil {
+			return "", err
+		}
+	}
+
+	res, err := json.Marshal(canonicalizeValidation(value, false))
+	if err != nil {
+		return "", err
+	}
+
+	return string(res), nil
+}
+
+func canonicalizeValidation(v interface{}, unordered bool) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(t))
+		for k, e := range t {
+			result[k] = canonicalizeValidation(e, unorderedValidationKeys[k])
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(t))
+		for i, e := range t {
+			result[i] = canonicalizeValidation(e, false)
+		}
+
+		if unordered {
+			keys := make([]string, len(result))
+			for i, e := range result {
+				b, _ := json.Marshal(e)
+				keys[i] = string(b)
+			}
+			sort.Sort(byKey{keys: keys, values: result})
+		}
+		return result
+	}
+	return v
+}
+
+type byKey struct {
+	keys   []string
+	values []interface{}
+}
+
+func (b byKey) Len() int           { return len(b.keys) }
+func (b byKey) Less(i, j int) bool { return b.keys[i] < b.keys[j] }
+func (b byKey) Swap(i, j int) {
+	b.keys[i], b.keys[j] = b.keys[j], b.keys[i]
+	b.values[i], b.values[j] = b.values[j], b.values[i]
+}
+
+// validationSetsEqual reports whether two lists of JSON validations contain
+// the same validations, ignoring order.
+func validationSetsEqual(old, new interface{}) bool {
+	o, _ := old.([]interface{})
+	n, _ := new.([]interface{})
+
+	if len(o) != len(n) {
+		return false
+	}
+
+	counts := make(map[string]int)
+	for _, e := range o {
+		canonical, err := canonicalValidationJSON(e)
+		if err != nil {
+			return false
+		}
+		counts[canonical]++
+	}
+
+	for _, e := range n {
+		canonical, err := canonicalValidationJSON(e)
+		if err != nil || counts[canonical] == 0 {
+			return false
+		}
+		counts[canonical]--
+	}
+
+	return true
+}
+
+// validationStateFunc stores JSON validations in their canonical form.
+func validationStateFunc(v interface{}) string {
+	s, _ := v.(string)
+	canonical, err := canonicalValidationJSON(s)
+	if err != nil {
+		return s
+	}
+	return canonical
+}
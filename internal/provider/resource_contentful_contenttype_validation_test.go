@@ -0,0 +1,333 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestConvertValidationForWriting(t *testing.T) {
+	cases := []struct {
+		name       string
+		validation map[string]interface{}
+		fieldType  string
+		want       string
+		wantErr    bool
+	}{
+		{
+			name: "size",
+			validation: map[string]interface{}{
+				"size":    []interface{}{map[string]interface{}{"min": 1, "max": 0}},
+				"message": "too long",
+			},
+			fieldType: "Symbol",
+			want:      `{"message":"too long","size":{"min":1}}`,
+		},
+		{
+			name: "range",
+			validation: map[string]interface{}{
+				"range": []interface{}{map[string]interface{}{"min": "0.5", "max": "10"}},
+			},
+			fieldType: "Number",
+			want:      `{"range":{"max":10,"min":0.5}}`,
+		},
+		{
+			name: "date range",
+			validation: map[string]interface{}{
+				"date_range": []interface{}{map[string]interface{}{"min": "2020-01-01", "max": ""}},
+			},
+			fieldType: "Date",
+			want:      `{"dateRange":{"min":"2020-01-01"}}`,
+		},
+		{
+			name: "regexp",
+			validation: map[string]interface{}{
+				"regexp": []interface{}{map[string]interface{}{"pattern": "^a", "flags": "i"}},
+			},
+			fieldType: "Symbol",
+			want:      `{"regexp":{"flags":"i","pattern":"^a"}}`,
+		},
+		{
+			name:       "unique",
+			validation: map[string]interface{}{"unique": true},
+			fieldType:  "Symbol",
+			want:       `{"unique":true}`,
+		},
+		{
+			name:       "in on a Symbol field",
+			validation: map[string]interface{}{"in": []interface{}{"a", "b"}},
+			fieldType:  "Symbol",
+			want:       `{"in":["a","b"]}`,
+		},
+		{
+			name:       "in on an Integer field",
+			validation: map[string]interface{}{"in": []interface{}{"1", "2"}},
+			fieldType:  "Integer",
+			want:       `{"in":[1,2]}`,
+		},
+		{
+			name:       "in with a non numeric value on a Number field",
+			validation: map[string]interface{}{"in": []interface{}{"a"}},
+			fieldType:  "Number",
+			wantErr:    true,
+		},
+		{
+			name:       "link content type",
+			validation: map[string]interface{}{"link_content_type": []interface{}{"author"}},
+			fieldType:  "Link",
+			want:       `{"linkContentType":["author"]}`,
+		},
+		{
+			name: "asset image dimensions",
+			validation: map[string]interface{}{
+				"asset_image_dimensions": []interface{}{map[string]interface{}{
+					"width":  []interface{}{map[string]interface{}{"min": 100, "max": 200}},
+					"height": []interface{}{},
+				}},
+			},
+			fieldType: "Link",
+			want:      `{"assetImageDimensions":{"width":{"max":200,"min":100}}}`,
+		},
+		{
+			name: "nodes",
+			validation: map[string]interface{}{
+				"nodes": []interface{}{map[string]interface{}{
+					"node_type":         "embedded-entry-block",
+					"link_content_type": []interface{}{"author"},
+					"size":              []interface{}{map[string]interface{}{"max": 3}},
+					"message":           "authors only",
+				}},
+			},
+			fieldType: "RichText",
+			want:      `{"nodes":{"embedded-entry-block":[{"linkContentType":["author"],"message":"authors only"},{"message":"authors only","size":{"max":3}}]}}`,
+		},
+		{
+			name:       "no kind",
+			validation: map[string]interface{}{"message": "nothing"},
+			fieldType:  "Symbol",
+			wantErr:    true,
+		},
+		{
+			name: "more than one kind",
+			validation: map[string]interface{}{
+				"unique": true,
+				"in":     []interface{}{"a"},
+			},
+			fieldType: "Symbol",
+			wantErr:   true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := convertValidationForWriting(c.validation, c.fieldType)
+			if c.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			b, err := json.Marshal(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != c.want {
+				t.Errorf("got %s, want %s", b, c.want)
+			}
+		})
+	}
+}
+
+func TestConvertValidationForReading(t *testing.T) {
+	cases := []struct {
+		name      string
+		json      string
+		fieldType string
+		typed     bool
+	}{
+		{name: "size", json: `{"size":{"min":1,"max":10},"message":"length"}`, fieldType: "Symbol", typed: true},
+		{name: "range", json: `{"range":{"min":0.5}}`, fieldType: "Number", typed: true},
+		{name: "regexp", json: `{"regexp":{"pattern":"^a","flags":"i"}}`, fieldType: "Symbol", typed: true},
+		{name: "unique", json: `{"unique":true}`, fieldType: "Symbol", typed: true},
+		{name: "in on an Integer field", json: `{"in":[1,2]}`, fieldType: "Integer", typed: true},
+		{name: "link mimetype group", json: `{"linkMimetypeGroup":["image"]}`, fieldType: "Link", typed: true},
+		{name: "asset file size", json: `{"assetFileSize":{"max":1048576}}`, fieldType: "Link", typed: true},
+		{name: "asset image dimensions", json: `{"assetImageDimensions":{"height":{"max":200}}}`, fieldType: "Link", typed: true},
+		{name: "nodes", json: `{"nodes":{"entry-hyperlink":[{"linkContentType":["page"]}]}}`, fieldType: "RichText", typed: true},
+		{name: "unique false", json: `{"unique":false}`, fieldType: "Symbol", typed: false},
+		{name: "in with an object", json: `{"in":[{"a":1}]}`, fieldType: "Symbol", typed: false},
+		{name: "size with a zero bound", json: `{"size":{"min":0,"max":10}}`, fieldType: "Symbol", typed: false},
+		{name: "unknown validation", json: `{"someday":{"a":1}}`, fieldType: "Symbol", typed: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			v := make(map[string]interface{})
+			if err := json.Unmarshal([]byte(c.json), &v); err != nil {
+				t.Fatal(err)
+			}
+
+			typed, ok := convertValidationForReading(v, c.fieldType)
+			if ok != c.typed {
+				t.Fatalf("got typed %v, want %v", ok, c.typed)
+			}
+			if !ok {
+				return
+			}
+
+			written, err := convertValidationForWriting(typed, c.fieldType)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !jsonEqual(written, v) {
+				t.Errorf("round trip changed the validation: got %v, want %v", written, v)
+			}
+		})
+	}
+}
+
+func TestProcessValidationForReading(t *testing.T) {
+	validations := []interface{}{
+		map[string]interface{}{"unique": true},
+		map[string]interface{}{"someday": "x"},
+	}
+
+	typed, strs, err := processValidationForReading(validations, "Symbol", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(typed) != 1 || len(strs) != 1 || strs[0] != `{"someday":"x"}` {
+		t.Errorf("got typed %v and JSON %v", typed, strs)
+	}
+
+	// validations kept as JSON in state stay JSON
+	current := &currentValidations{
+		raw:   map[string]bool{`{"unique":true}`: true},
+		order: map[string]int{`{"someday":"x"}`: 0, `{"unique":true}`: 1},
+	}
+
+	typed, strs, err = processValidationForReading(validations, "Symbol", current)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(typed) != 0 || len(strs) != 2 || strs[0] != `{"someday":"x"}` || strs[1] != `{"unique":true}` {
+		t.Errorf("got typed %v and JSON %v", typed, strs)
+	}
+}
+
+func TestCanonicalValidationJSON(t *testing.T) {
+	cases := []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{name: "sorted keys", value: `{"size":{"max":10,"min":1},"message":"m"}`, want: `{"message":"m","size":{"max":10,"min":1}}`},
+		{name: "normalized numbers", value: `{"range":{"min":1.0,"max":1e1}}`, want: `{"range":{"max":10,"min":1}}`},
+		{name: "unordered in", value: `{"in":["b","a","c"]}`, want: `{"in":["a","b","c"]}`},
+		{name: "unordered link content types", value: `{"linkContentType":["page","author"]}`, want: `{"linkContentType":["author","page"]}`},
+		{name: "unordered arrays in nodes", value: `{"nodes":{"entry-hyperlink":[{"linkContentType":["b","a"]}]}}`, want: `{"nodes":{"entry-hyperlink":[{"linkContentType":["a","b"]}]}}`},
+		{name: "ordered node validations", value: `{"nodes":{"x":[{"size":{"max":1}},{"linkContentType":["a"]}]}}`, want: `{"nodes":{"x":[{"size":{"max":1}},{"linkContentType":["a"]}]}}`},
+		{name: "decoded object", value: map[string]interface{}{"in": []interface{}{2, 1}}, want: `{"in":[1,2]}`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := canonicalValidationJSON(c.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != c.want {
+				t.Errorf("got %s, want %s", got, c.want)
+			}
+		})
+	}
+
+	if _, err := canonicalValidationJSON(`{"in":`); err == nil {
+		t.Error("expected an error for invalid JSON")
+	}
+}
+
+func TestValidationSetsEqual(t *testing.T) {
+	cases := []struct {
+		name string
+		old  []interface{}
+		new  []interface{}
+		want bool
+	}{
+		{
+			name: "same order",
+			old:  []interface{}{`{"unique":true}`, `{"in":["a"]}`},
+			new:  []interface{}{`{"unique":true}`, `{"in":["a"]}`},
+			want: true,
+		},
+		{
+			name: "different order",
+			old:  []interface{}{`{"unique":true}`, `{"in":["a","b"]}`},
+			new:  []interface{}{`{"in":["b","a"]}`, `{"unique":true}`},
+			want: true,
+		},
+		{
+			name: "different values",
+			old:  []interface{}{`{"in":["a"]}`},
+			new:  []interface{}{`{"in":["b"]}`},
+			want: false,
+		},
+		{
+			name: "duplicates",
+			old:  []interface{}{`{"unique":true}`, `{"unique":true}`},
+			new:  []interface{}{`{"unique":true}`, `{"in":["a"]}`},
+			want: false,
+		},
+		{
+			name: "different length",
+			old:  []interface{}{`{"unique":true}`},
+			new:  []interface{}{},
+			want: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := validationSetsEqual(c.old, c.new); got != c.want {
+				t.Errorf("got %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestConvertFieldsForReadingKeepsNumericInValues(t *testing.T) {
+	fields := []interface{}{
+		map[string]interface{}{"id": "price", "type": "Number", "validations": []interface{}{map[string]interface{}{"in": []interface{}{1.5, 2.0, 3.0}}}},
+		map[string]interface{}{"id": "code", "type": "Symbol", "validations": []interface{}{map[string]interface{}{"in": []interface{}{"1.5"}}}},
+	}
+
+	in := func(values ...interface{}) []interface{} {
+		return []interface{}{map[string]interface{}{"in": values}}
+	}
+
+	current := []interface{}{
+		map[string]interface{}{"id": "price", "type": "Number", "validation": in("1.50", "2.0", "4")},
+		map[string]interface{}{"id": "code", "type": "Symbol", "validation": in("1.50")},
+	}
+
+	if err := convertFieldsForReading(fields, current); err != nil {
+		t.Fatal(err)
+	}
+
+	// values are written as in state when they are the same number, values
+	// not in state are read as Contentful returns them
+	price := singleBlock(fields[0].(map[string]interface{})["validation"])
+	if got := price["in"]; !reflect.DeepEqual(got, []interface{}{"1.50", "2.0", "3"}) {
+		t.Errorf("got in %v for a Number field", got)
+	}
+
+	// values of Symbol fields are strings, 1.5 and 1.50 differ
+	code := singleBlock(fields[1].(map[string]interface{})["validation"])
+	if got := code["in"]; !reflect.DeepEqual(got, []interface{}{"1.5"}) {
+		t.Errorf("got in %v for a Symbol field", got)
+	}
+}
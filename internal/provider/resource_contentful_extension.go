@@ -0,0 +1,308 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// maxSrcdocSize is the largest srcdoc Contentful accepts.
+const maxSrcdocSize = 200 * 1024
+
+func resourceContentfulExtension() *schema.Resource {
+	return &schema.Resource{
+		Description: "A UI extension of an environment, hosted at `src` or inlined from a local HTML file.",
+
+		CreateContext: resourceExtensionCreate,
+		ReadContext:   resourceExtensionRead,
+		UpdateContext: resourceExtensionUpdate,
+		DeleteContext: resourceExtensionDelete,
+		CustomizeDiff: resourceExtensionCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"env_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The environment id. Defaults to the provider `env`.",
+			},
+			"extension_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"src": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"src", "srcdoc_file"},
+				Description:  "URL the extension is hosted at",
+			},
+			"srcdoc_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"src", "srcdoc_file"},
+				Description:  "Path to an HTML file that is uploaded as the inline `srcdoc` of the extension, at most 200KB",
+			},
+			"srcdoc_hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 of the inline `srcdoc`",
+			},
+			"field_type": fieldTypeSchema("Field types the extension can be used with"),
+			"sidebar": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Renders the extension in the entry sidebar instead of as a field editor",
+			},
+			"instance_parameter":     appParameterSchema("Parameters set for every instance of the extension, e.g. per field it is used with"),
+			"installation_parameter": appParameterSchema("Parameters set once for the extension in the environment"),
+			"parameters": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsJSON),
+				DiffSuppressFunc: jsonDiff,
+				Description:      "JSON object with the values of the installation parameters",
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importEnvResource("extension_id"),
+		},
+	}
+}
+
+func resourceExtensionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("srcdoc_file") {
+		return d.SetNewComputed("srcdoc_hash")
+	}
+
+	path := d.Get("srcdoc_file").(string)
+	if path == "" {
+		if d.Get("srcdoc_hash").(string) != "" {
+			return d.SetNew("srcdoc_hash", "")
+		}
+		return nil
+	}
+
+	srcdoc, err := readSrcdoc(path)
+	if err != nil {
+		return err
+	}
+
+	if hash := srcdocHash(srcdoc); hash != d.Get("srcdoc_hash").(string) {
+		return d.SetNew("srcdoc_hash", hash)
+	}
+
+	return nil
+}
+
+// readSrcdoc reads an srcdoc_file, failing for files larger than Contentful
+// accepts.
+func readSrcdoc(path string) (string, error) {
+	srcdoc, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read srcdoc_file: %s", err.Error())
+	}
+
+	if len(srcdoc) > maxSrcdocSize {
+		return "", fmt.Errorf("srcdoc_file %s is %d bytes, but Contentful accepts at most %d bytes (200KB). Host the extension and set src instead", path, len(srcdoc), maxSrcdocSize)
+	}
+
+	return string(srcdoc), nil
+}
+
+func srcdocHash(srcdoc string) string {
+	sum := sha256.Sum256([]byte(srcdoc))
+	return hex.EncodeToString(sum[:])
+}
+
+func resourceExtensionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerData).client
+
+	spaceID := d.Get("space_id").(string)
+	envID := client.ResolveEnv(d.Get("env_id").(string))
+	id := d.Get("extension_id").(string)
+
+	if envID == "" {
+		return diag.Errorf("env_id must be set when the provider env is not configured")
+	}
+
+	d.Set("env_id", envID)
+
+	if diags := putExtension(ctx, d, meta, spaceID, envID, id, 0); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", spaceID, envID, id))
+
+	return nil
+}
+
+func resourceExtensionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids := strings.Split(d.Id(), "/")
+	if len(ids) != 3 {
+		return diag.Errorf("Got invalid id: %s", d.Id())
+	}
+	spaceID := ids[0]
+	envID := ids[1]
+	id := ids[2]
+
+	client := meta.(*providerData).client.Env(envID)
+
+	res, err := client.Extension.Read(ctx, spaceID, envID, id)
+
+	if err != nil && strings.Contains(err.Error(), "status code 404") {
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return diag.Errorf("Unknown error when getting extension with id:%s : %s", d.Id(), err.Error())
+	}
+
+	extension, _ := res["extension"].(map[string]interface{})
+	parameters, _ := extension["parameters"].(map[string]interface{})
+
+	srcdocHashValue := ""
+	if srcdoc, ok := extension["srcdoc"].(string); ok {
+		srcdocHashValue = srcdocHash(srcdoc)
+	}
+
+	values := ""
+	if v, ok := res["parameters"].(map[string]interface{}); ok && len(v) > 0 {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return diag.Errorf("Unknown error when processing parameters of extension:%s : %s", d.Id(), err.Error())
+		}
+		values = string(b)
+	}
+
+	d.Set("space_id", spaceID)
+	d.Set("env_id", envID)
+	d.Set("extension_id", id)
+	d.Set("name", extension["name"])
+	d.Set("src", extension["src"])
+	d.Set("srcdoc_hash", srcdocHashValue)
+	d.Set("field_type", convertFieldTypesForReading(extension["fieldTypes"]))
+	d.Set("sidebar", extension["sidebar"])
+	d.Set("instance_parameter", convertAppParametersForReading(parameters["instance"]))
+	d.Set("installation_parameter", convertAppParametersForReading(parameters["installation"]))
+	d.Set("parameters", values)
+	d.Set("version", getVersion(res))
+
+	return diags
+}
+
+func resourceExtensionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ids := strings.Split(d.Id(), "/")
+	if len(ids) != 3 {
+		return diag.Errorf("Got invalid id: %s", d.Id())
+	}
+
+	return putExtension(ctx, d, meta, ids[0], ids[1], ids[2], d.Get("version").(int))
+}
+
+func putExtension(ctx context.Context, d *schema.ResourceData, meta interface{}, spaceID string, envID string, id string, version int) diag.Diagnostics {
+	client := meta.(*providerData).client.Env(envID)
+
+	extension := map[string]interface{}{
+		"name":       d.Get("name").(string),
+		"fieldTypes": convertFieldTypesForWriting(d.Get("field_type")),
+		"sidebar":    d.Get("sidebar").(bool),
+	}
+
+	if v, ok := d.GetOk("src"); ok {
+		extension["src"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("srcdoc_file"); ok {
+		srcdoc, err := readSrcdoc(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		extension["srcdoc"] = srcdoc
+	}
+
+	parameters := make(map[string]interface{})
+	for key, name := range map[string]string{"instance_parameter": "instance", "installation_parameter": "installation"} {
+		p, err := convertAppParametersForWriting(d.Get(key))
+		if err != nil {
+			return diag.Errorf("Unknown error when converting extension parameters: %s", err.Error())
+		}
+		if len(p) > 0 {
+			parameters[name] = p
+		}
+	}
+	if len(parameters) > 0 {
+		extension["parameters"] = parameters
+	}
+
+	body := map[string]interface{}{"extension": extension}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		values := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(v.(string)), &values); err != nil {
+			return diag.Errorf("parameters must be a JSON object: %s", err.Error())
+		}
+		body["parameters"] = values
+	}
+
+	res, err := client.Extension.Put(ctx, spaceID, envID, id, version, body)
+	if err != nil {
+		return diag.Errorf("Unknown error when updating extension: %s", err.Error())
+	}
+
+	if srcdoc, ok := extension["srcdoc"].(string); ok {
+		d.Set("srcdoc_hash", srcdocHash(srcdoc))
+	} else {
+		d.Set("srcdoc_hash", "")
+	}
+	d.Set("version", getVersion(res))
+
+	return nil
+}
+
+func resourceExtensionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids := strings.Split(d.Id(), "/")
+	if len(ids) != 3 {
+		return diag.Errorf("Got invalid id: %s", d.Id())
+	}
+	spaceID := ids[0]
+	envID := ids[1]
+	id := ids[2]
+
+	client := meta.(*providerData).client.Env(envID)
+
+	err := client.Extension.Delete(ctx, spaceID, envID, id, d.Get("version").(int))
+	if err != nil && !strings.Contains(err.Error(), "status code 404") {
+		return diag.Errorf("Unknown error when deleting extension: %s", err.Error())
+	}
+
+	d.SetId("")
+	return diags
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/the-urge-tech/terraform-provider-contentful/pkg/contentful"
+)
+
+func TestExtensionSrcdocSize(t *testing.T) {
+	meta := &providerData{client: contentful.NewClient("token", "org", "space", "master")}
+	dir := t.TempDir()
+
+	small := filepath.Join(dir, "small.html")
+	if err := os.WriteFile(small, []byte(strings.Repeat("a", maxSrcdocSize)), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	large := filepath.Join(dir, "large.html")
+	if err := os.WriteFile(large, []byte(strings.Repeat("a", maxSrcdocSize+1)), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	config := func(path string) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"space_id":     "space",
+			"env_id":       "master",
+			"extension_id": "slug",
+			"name":         "Slug editor",
+			"srcdoc_file":  path,
+		})
+	}
+
+	diff, err := resourceContentfulExtension().Diff(context.Background(), nil, config(small), meta)
+	if err != nil {
+		t.Fatalf("expected a file of %d bytes to be accepted, got %v", maxSrcdocSize, err)
+	}
+	if diff.Attributes["srcdoc_hash"].New != srcdocHash(strings.Repeat("a", maxSrcdocSize)) {
+		t.Errorf("got srcdoc_hash %v", diff.Attributes["srcdoc_hash"])
+	}
+
+	_, err = resourceContentfulExtension().Diff(context.Background(), nil, config(large), meta)
+	if err == nil || !strings.Contains(err.Error(), "accepts at most") {
+		t.Errorf("expected the plan to fail for a file over 200KB, got %v", err)
+	}
+}
@@ -0,0 +1,264 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var scheduledActionEntityTypes = []string{"Entry", "Release"}
+var scheduledActions = []string{"publish", "unpublish"}
+
+func resourceContentfulScheduledAction() *schema.Resource {
+	return &schema.Resource{
+		Description: "Schedules publishing or unpublishing an entry or release. Destroying it cancels the action when it did not run yet.",
+
+		CreateContext: resourceScheduledActionCreate,
+		ReadContext:   resourceScheduledActionRead,
+		UpdateContext: resourceScheduledActionUpdate,
+		DeleteContext: resourceScheduledActionDelete,
+		CustomizeDiff: resourceScheduledActionCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"env_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The environment id. Defaults to the provider `env`.",
+			},
+			"entity_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "Entry",
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(scheduledActionEntityTypes, false)),
+				Description:      "Either `Entry` or `Release`",
+			},
+			"entity_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"action": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(scheduledActions, false)),
+				Description:      "Either `publish` or `unpublish`",
+			},
+			"datetime": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+				DiffSuppressFunc: rfc3339Diff,
+				Description:      "When the action runs, as an RFC3339 time",
+			},
+			"timezone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "IANA timezone the action is shown in by the web app, e.g. `Europe/Berlin`",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "One of `scheduled`, `succeeded`, `failed` or `canceled`",
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importEnvResource("scheduled_action_id"),
+		},
+	}
+}
+
+// rfc3339Diff suppresses differences between two RFC3339 times that are the
+// same instant.
+func rfc3339Diff(k, old, new string, d *schema.ResourceData) bool {
+	o, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+
+	n, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+
+	return o.Equal(n)
+}
+
+// resourceScheduledActionCustomizeDiff replaces actions that already ran or
+// were canceled, since only scheduled actions can be updated.
+func resourceScheduledActionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || d.Get("status").(string) == "scheduled" {
+		return nil
+	}
+
+	for _, key := range []string{"action", "datetime", "timezone"} {
+		if d.HasChange(key) {
+			if err := d.ForceNew(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceScheduledActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*providerData).client
+
+	spaceID := d.Get("space_id").(string)
+	envID := client.ResolveEnv(d.Get("env_id").(string))
+
+	if envID == "" {
+		return diag.Errorf("env_id must be set when the provider env is not configured")
+	}
+
+	res, err := client.Env(envID).ScheduledAction.Create(ctx, spaceID, envID, scheduledActionBody(d, envID))
+	if err != nil {
+		return diag.Errorf("Unknown error when scheduling action: %s", err.Error())
+	}
+
+	sys := res["sys"].(map[string]interface{})
+
+	d.Set("env_id", envID)
+	d.Set("status", sys["status"])
+	d.Set("version", getVersion(res))
+	d.SetId(fmt.Sprintf("%s/%s/%s", spaceID, envID, sys["id"]))
+
+	return diags
+}
+
+func resourceScheduledActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids := strings.Split(d.Id(), "/")
+	if len(ids) != 3 {
+		return diag.Errorf("Got invalid id: %s", d.Id())
+	}
+	spaceID := ids[0]
+	envID := ids[1]
+	id := ids[2]
+
+	client := meta.(*providerData).client.Env(envID)
+
+	action, err := client.ScheduledAction.Read(ctx, spaceID, envID, id)
+
+	if err != nil && strings.Contains(err.Error(), "status code 404") {
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return diag.Errorf("Unknown error when getting scheduled action with id:%s : %s", d.Id(), err.Error())
+	}
+
+	sys, _ := action["sys"].(map[string]interface{})
+
+	// canceled outside of Terraform, schedule it again
+	if sys["status"] == "canceled" {
+		d.SetId("")
+		return diags
+	}
+
+	entity, _ := action["entity"].(map[string]interface{})
+	entitySys, _ := entity["sys"].(map[string]interface{})
+	scheduledFor, _ := action["scheduledFor"].(map[string]interface{})
+
+	d.Set("space_id", spaceID)
+	d.Set("env_id", envID)
+	d.Set("entity_type", entitySys["linkType"])
+	d.Set("entity_id", entitySys["id"])
+	d.Set("action", action["action"])
+	d.Set("datetime", scheduledFor["datetime"])
+	d.Set("timezone", scheduledFor["timezone"])
+	d.Set("status", sys["status"])
+	d.Set("version", getVersion(action))
+
+	return diags
+}
+
+func resourceScheduledActionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids := strings.Split(d.Id(), "/")
+	if len(ids) != 3 {
+		return diag.Errorf("Got invalid id: %s", d.Id())
+	}
+	spaceID := ids[0]
+	envID := ids[1]
+	id := ids[2]
+
+	client := meta.(*providerData).client.Env(envID)
+
+	res, err := client.ScheduledAction.Update(ctx, spaceID, envID, id, d.Get("version").(int), scheduledActionBody(d, envID))
+	if err != nil {
+		return diag.Errorf("Unknown error when updating scheduled action: %s", err.Error())
+	}
+
+	sys, _ := res["sys"].(map[string]interface{})
+
+	d.Set("status", sys["status"])
+	d.Set("version", getVersion(res))
+
+	return diags
+}
+
+func resourceScheduledActionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids := strings.Split(d.Id(), "/")
+	if len(ids) != 3 {
+		return diag.Errorf("Got invalid id: %s", d.Id())
+	}
+	spaceID := ids[0]
+	envID := ids[1]
+	id := ids[2]
+
+	// actions that already ran cannot be canceled
+	if d.Get("status").(string) != "scheduled" {
+		d.SetId("")
+		return diags
+	}
+
+	client := meta.(*providerData).client.Env(envID)
+
+	err := client.ScheduledAction.Cancel(ctx, spaceID, envID, id)
+	if err != nil && !strings.Contains(err.Error(), "status code 404") {
+		return diag.Errorf("Unknown error when canceling scheduled action: %s", err.Error())
+	}
+
+	d.SetId("")
+	return diags
+}
+
+func scheduledActionBody(d *schema.ResourceData, envID string) map[string]interface{} {
+	scheduledFor := map[string]interface{}{
+		"datetime": d.Get("datetime").(string),
+	}
+	if timezone, _ := d.Get("timezone").(string); timezone != "" {
+		scheduledFor["timezone"] = timezone
+	}
+
+	return map[string]interface{}{
+		"entity":       map[string]interface{}{"sys": link(d.Get("entity_type").(string), d.Get("entity_id").(string))},
+		"environment":  map[string]interface{}{"sys": link("Environment", envID)},
+		"action":       d.Get("action").(string),
+		"scheduledFor": scheduledFor,
+	}
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/the-urge-tech/terraform-provider-contentful/pkg/contentful"
+)
+
+func TestRFC3339Diff(t *testing.T) {
+	cases := []struct {
+		old  string
+		new  string
+		want bool
+	}{
+		{old: "2030-01-01T10:00:00Z", new: "2030-01-01T10:00:00Z", want: true},
+		{old: "2030-01-01T10:00:00Z", new: "2030-01-01T11:00:00+01:00", want: true},
+		{old: "2030-01-01T10:00:00.000Z", new: "2030-01-01T05:00:00-05:00", want: true},
+		{old: "2030-01-01T10:00:00Z", new: "2030-01-01T10:00:00+01:00", want: false},
+		{old: "2030-01-01T10:00:00Z", new: "2030-01-01 10:00", want: false},
+		{old: "", new: "2030-01-01T10:00:00Z", want: false},
+	}
+
+	for _, c := range cases {
+		if got := rfc3339Diff("datetime", c.old, c.new, nil); got != c.want {
+			t.Errorf("%q -> %q: got %v, want %v", c.old, c.new, got, c.want)
+		}
+	}
+}
+
+func TestScheduledActionPlan(t *testing.T) {
+	meta := &providerData{client: contentful.NewClient("token", "org", "space", "master")}
+
+	raw := func(datetime string) map[string]interface{} {
+		return map[string]interface{}{
+			"space_id":  "space",
+			"env_id":    "master",
+			"entity_id": "entry",
+			"action":    "publish",
+			"datetime":  datetime,
+		}
+	}
+
+	stateWithStatus := func(status string) *terraform.InstanceState {
+		d := schema.TestResourceDataRaw(t, resourceContentfulScheduledAction().Schema, raw("2030-01-01T10:00:00Z"))
+		d.Set("status", status)
+		d.SetId("space/master/action")
+		return d.State()
+	}
+
+	if diags := resourceContentfulScheduledAction().Validate(terraform.NewResourceConfigRaw(raw("2030-01-01 10:00"))); !diags.HasError() {
+		t.Error("expected a datetime that is not RFC3339 to fail the plan")
+	} else if !strings.Contains(diags[0].Summary, "RFC3339") {
+		t.Errorf("got %v", diags)
+	}
+
+	cases := []struct {
+		name        string
+		status      string
+		datetime    string
+		wantChange  bool
+		wantReplace bool
+	}{
+		{name: "same instant in another offset", status: "scheduled", datetime: "2030-01-01T11:00:00+01:00"},
+		{name: "scheduled", status: "scheduled", datetime: "2030-01-02T10:00:00Z", wantChange: true},
+		{name: "succeeded", status: "succeeded", datetime: "2030-01-02T10:00:00Z", wantChange: true, wantReplace: true},
+		{name: "failed", status: "failed", datetime: "2030-01-02T10:00:00Z", wantChange: true, wantReplace: true},
+		{name: "succeeded without changes", status: "succeeded", datetime: "2030-01-01T10:00:00Z"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			diff, err := resourceContentfulScheduledAction().Diff(context.Background(), stateWithStatus(c.status), terraform.NewResourceConfigRaw(raw(c.datetime)), meta)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if changed := diff != nil && !diff.Empty(); changed != c.wantChange {
+				t.Fatalf("got change %v, want %v: %v", changed, c.wantChange, diff)
+			}
+			if replaced := diff != nil && diff.RequiresNew(); replaced != c.wantReplace {
+				t.Errorf("got replace %v, want %v: %v", replaced, c.wantReplace, diff)
+			}
+		})
+	}
+}
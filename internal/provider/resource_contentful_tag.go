@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var tagVisibilities = []string{"private", "public"}
+
+func resourceContentfulTag() *schema.Resource {
+	return &schema.Resource{
+		Description: "A tag of an environment. Destroying a tag that entries are still tagged with fails.",
+
+		CreateContext: resourceTagCreate,
+		ReadContext:   resourceTagRead,
+		UpdateContext: resourceTagUpdate,
+		DeleteContext: resourceTagDelete,
+
+		Schema: map[string]*schema.Schema{
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"env_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The environment id. Defaults to the provider `env`.",
+			},
+			"tag_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"visibility": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "private",
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(tagVisibilities, false)),
+				Description:      "Either `private`, visible to space members only, or `public`, also delivered through the Content Delivery API. Contentful does not allow changing it",
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importEnvResource("tag_id"),
+		},
+	}
+}
+
+func resourceTagCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*providerData).client
+
+	spaceID := d.Get("space_id").(string)
+	envID := client.ResolveEnv(d.Get("env_id").(string))
+	id := d.Get("tag_id").(string)
+
+	if envID == "" {
+		return diag.Errorf("env_id must be set when the provider env is not configured")
+	}
+
+	res, err := client.Env(envID).Tag.Put(ctx, spaceID, envID, id, 0, tagBody(d, id))
+	if err != nil {
+		return diag.Errorf("Unknown error when creating tag: %s", err.Error())
+	}
+
+	d.Set("env_id", envID)
+	d.Set("version", getVersion(res))
+	d.SetId(fmt.Sprintf("%s/%s/%s", spaceID, envID, id))
+
+	return diags
+}
+
+func resourceTagRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids := strings.Split(d.Id(), "/")
+	if len(ids) != 3 {
+		return diag.Errorf("Got invalid id: %s", d.Id())
+	}
+	spaceID := ids[0]
+	envID := ids[1]
+	id := ids[2]
+
+	client := meta.(*providerData).client.Env(envID)
+
+	tag, err := client.Tag.Read(ctx, spaceID, envID, id)
+
+	if err != nil && strings.Contains(err.Error(), "status code 404") {
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return diag.Errorf("Unknown error when getting tag with id:%s : %s", d.Id(), err.Error())
+	}
+
+	sys, _ := tag["sys"].(map[string]interface{})
+
+	d.Set("space_id", spaceID)
+	d.Set("env_id", envID)
+	d.Set("tag_id", id)
+	d.Set("name", tag["name"])
+	d.Set("visibility", sys["visibility"])
+	d.Set("version", getVersion(tag))
+
+	return diags
+}
+
+func resourceTagUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids := strings.Split(d.Id(), "/")
+	if len(ids) != 3 {
+		return diag.Errorf("Got invalid id: %s", d.Id())
+	}
+	spaceID := ids[0]
+	envID := ids[1]
+	id := ids[2]
+
+	client := meta.(*providerData).client.Env(envID)
+
+	res, err := client.Tag.Put(ctx, spaceID, envID, id, d.Get("version").(int), tagBody(d, id))
+	if err != nil {
+		return diag.Errorf("Unknown error when updating tag: %s", err.Error())
+	}
+
+	d.Set("version", getVersion(res))
+
+	return diags
+}
+
+func resourceTagDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids := strings.Split(d.Id(), "/")
+	if len(ids) != 3 {
+		return diag.Errorf("Got invalid id: %s", d.Id())
+	}
+	spaceID := ids[0]
+	envID := ids[1]
+	id := ids[2]
+
+	client := meta.(*providerData).client.Env(envID)
+
+	count, err := client.Tag.CountEntries(ctx, spaceID, envID, id)
+	if err != nil {
+		return diag.Errorf("Unknown error when getting entries of tag: %s", err.Error())
+	}
+
+	if count > 0 {
+		return diag.Errorf("Tag %s is still used by %d entries, remove it from them before destroying it", d.Id(), count)
+	}
+
+	err = client.Tag.Delete(ctx, spaceID, envID, id, d.Get("version").(int))
+	if err != nil && !strings.Contains(err.Error(), "status code 404") {
+		return diag.Errorf("Unknown error when deleting tag: %s", err.Error())
+	}
+
+	d.SetId("")
+	return diags
+}
+
+func tagBody(d *schema.ResourceData, id string) map[string]interface{} {
+	return map[string]interface{}{
+		"name": d.Get("name").(string),
+		"sys": map[string]interface{}{
+			"id":         id,
+			"visibility": d.Get("visibility").(string),
+		},
+	}
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/the-urge-tech/terraform-provider-contentful/pkg/contentful"
+)
+
+// roundTripFunc answers the requests of contentful.Client in tests.
+type roundTripFunc func(*http.Request) *http.Response
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r), nil
+}
+
+// stubTransport serves the requests of every client with f until the test
+// ends.
+func stubTransport(t *testing.T, f roundTripFunc) {
+	t.Helper()
+
+	original := http.DefaultTransport
+	http.DefaultTransport = f
+	t.Cleanup(func() { http.DefaultTransport = original })
+}
+
+func jsonResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestTagBody(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceContentfulTag().Schema, map[string]interface{}{
+		"space_id": "space",
+		"tag_id":   "editorial",
+		"name":     "Editorial",
+	})
+
+	want := map[string]interface{}{
+		"name": "Editorial",
+		"sys":  map[string]interface{}{"id": "editorial", "visibility": "private"},
+	}
+	if got := tagBody(d, "editorial"); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestTagImport(t *testing.T) {
+	cases := []struct {
+		name    string
+		client  *contentful.Client
+		id      string
+		want    string
+		wantErr bool
+	}{
+		{name: "full id", client: contentful.NewClient("token", "org", "", ""), id: "space/staging/editorial", want: "space/staging/editorial"},
+		{name: "tag id", client: contentful.NewClient("token", "org", "space", "master"), id: "editorial", want: "space/master/editorial"},
+		{name: "tag id without provider env", client: contentful.NewClient("token", "org", "space", ""), id: "editorial", wantErr: true},
+		{name: "two parts", client: contentful.NewClient("token", "org", "space", "master"), id: "master/editorial", wantErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := resourceContentfulTag().Data(nil)
+			d.SetId(c.id)
+
+			res, err := resourceContentfulTag().Importer.StateContext(context.Background(), d, &providerData{client: c.client})
+			if c.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %v", res[0].Id())
+				}
+				if !strings.Contains(err.Error(), "<tag_id>") {
+					t.Errorf("expected the error to name tag_id, got %s", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res[0].Id() != c.want {
+				t.Errorf("got %s, want %s", res[0].Id(), c.want)
+			}
+		})
+	}
+}
+
+func TestCheckManagedTag(t *testing.T) {
+	stubTransport(t, func(r *http.Request) *http.Response {
+		switch r.URL.Path {
+		case "/spaces/space/environments/master/tags/managedByTerraform":
+			return jsonResponse(200, `{"name":"Managed by Terraform","sys":{"id":"managedByTerraform","version":1}}`)
+		case "/spaces/space/environments/staging/tags/managedByTerraform":
+			return jsonResponse(404, `{"sys":{"type":"Error","id":"NotFound"}}`)
+		}
+		return jsonResponse(500, `{"sys":{"type":"Error","id":"ServerError"}}`)
+	})
+
+	client := contentful.NewClient("token", "org", "space", "master")
+	data := &providerData{client: client, managedMarker: managedMarkerTag, managedTagID: "managedByTerraform"}
+
+	if diags := checkManagedTag(context.Background(), client, data, "space", "master"); diags.HasError() {
+		t.Errorf("expected an existing tag to pass, got %v", diags)
+	}
+
+	diags := checkManagedTag(context.Background(), client, data, "space", "staging")
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "does not exist") {
+		t.Errorf("expected a missing tag to be reported as missing, got %v", diags)
+	}
+
+	diags = checkManagedTag(context.Background(), client, data, "space", "broken")
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "Unknown error") {
+		t.Errorf("expected other errors to be reported as they are, got %v", diags)
+	}
+
+	// nothing is read when content types are not marked with the tag
+	data.managedMarker = managedMarkerDescription
+	if diags := checkManagedTag(context.Background(), client, data, "space", "broken"); diags.HasError() {
+		t.Errorf("expected no check, got %v", diags)
+	}
+}
@@ -0,0 +1,71 @@
+resource "contentful_contenttype" "blogPost" {
+  content_type_id = "blogPost"
+  name            = "Blog post"
+  annotation {
+    id = "Contentful:AggregateRoot"
+  }
+  description   = "Posts of the blog"
+  display_field = "title"
+  env_id        = "master"
+  field {
+    id   = "title"
+    name = "Title"
+    type = "Symbol"
+    annotation {
+      id         = "Contentful:GraphQLFieldResolver"
+      parameters = "{\"appFunctionId\":\"resolve\"}"
+    }
+    default_value {
+      locale = "en-US"
+      value  = "Untitled"
+    }
+    localized = true
+    required  = true
+    validation {
+      message = "too long"
+      size {
+        max = 80
+        min = 1
+      }
+    }
+    validation {
+      unique = true
+    }
+  }
+  field {
+    id   = "rating"
+    name = "Rating"
+    type = "Integer"
+    default_value {
+      locale = "en-US"
+      value  = "3"
+    }
+    validation {
+      in = ["1", "2", "3"]
+    }
+    validations = ["{\"someday\":{\"a\":1}}"]
+  }
+  field {
+    id   = "authors"
+    name = "Authors"
+    type = "Array"
+    items {
+      type      = "Link"
+      link_type = "Entry"
+      validation {
+        link_content_type = ["author"]
+      }
+    }
+  }
+  protected = true
+  space_id  = "space"
+  taxonomy {
+    concept_scheme_id = "topics"
+    required          = true
+  }
+}
+
+import {
+  to = contentful_contenttype.blogPost
+  id = "space/master/blogPost"
+}
@@ -42,7 +42,7 @@ func ConvertStringField(m map[string]interface{}, old string, new string) {
 
 func ConvertMapField(m map[string]interface{}, old string, new string) {
 	if m[old] != nil {
-		if len(m[old].(map[string]interface{})) > 0 {
+		if vm, ok := m[old].(map[string]interface{}); ok && len(vm) > 0 {
 			m[new] = m[old]
 		}
 
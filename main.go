@@ -3,10 +3,13 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 	"github.com/the-urge-tech/terraform-provider-contentful/internal/provider"
+	"github.com/the-urge-tech/terraform-provider-contentful/pkg/contentful"
 )
 
 // Run "go generate" to format example terraform files and generate the docs for the registry/website
@@ -29,6 +32,11 @@ var (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "generate" {
+		generate(os.Args[2:])
+		return
+	}
+
 	var debugMode bool
 
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
@@ -47,3 +55,34 @@ func main() {
 
 	plugin.Serve(opts)
 }
+
+// generate writes HCL and import blocks for every content type of an existing
+// space, e.g.
+//
+//	terraform-provider-contentful generate -space-id abc123 -env master -out ./contentful
+func generate(args []string) {
+	fs := flag.NewFlagSet("generate", flag.ExitOnError)
+	token := fs.String("cma-token", os.Getenv("CONTENTFUL_MANAGEMENT_TOKEN"), "the Contentful Management API token")
+	organizationID := fs.String("organization-id", os.Getenv("CONTENTFUL_ORGANIZATION_ID"), "the organization ID")
+	spaceID := fs.String("space-id", os.Getenv("CONTENTFUL_SPACE_ID"), "the space to generate content types from")
+	env := fs.String("env", os.Getenv("CONTENTFUL_ENVIRONMENT"), "the environment to generate content types from")
+	out := fs.String("out", ".", "the directory to write the generated files to")
+	managedMarker := fs.String("managed-marker", "description", "the managed_marker the provider is configured with")
+	managedDescriptionPrefix := fs.String("managed-description-prefix", "[DO NOT EDIT: Managed by Terraform] ", "the managed_description_prefix the provider is configured with")
+	fs.Parse(args)
+
+	if *token == "" || *spaceID == "" || *env == "" {
+		fs.Usage()
+		log.Fatal("cma-token, space-id and env are required")
+	}
+
+	client := contentful.NewClient(*token, *organizationID, *spaceID, *env)
+	files, err := provider.GenerateContentTypes(context.Background(), client, *spaceID, *env, *out, *managedMarker, *managedDescriptionPrefix)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	for _, f := range files {
+		fmt.Println(f)
+	}
+}
@@ -0,0 +1,158 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+type IAppBundleService interface {
+	Upload(ctx context.Context, organizationID string, file io.Reader) (string, error)
+	Create(ctx context.Context, organizationID string, appDefinitionID string, uploadID string, comment string) (map[string]interface{}, error)
+	Read(ctx context.Context, organizationID string, appDefinitionID string, id string) (map[string]interface{}, error)
+	Delete(ctx context.Context, organizationID string, appDefinitionID string, id string) error
+}
+
+type appBundleService struct {
+	c *Client
+}
+
+func NewAppBundleService(c *Client) IAppBundleService {
+	return &appBundleService{c: c}
+}
+
+// Upload uploads a zipped bundle and returns the id of the upload, to create
+// the bundle from with Create.
+func (s *appBundleService) Upload(ctx context.Context, organizationID string, file io.Reader) (string, error) {
+	orgPath, err := s.c.orgPath(organizationID)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := s.c.upload(ctx, orgPath+"/uploads", file)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, _ := io.ReadAll(res.Body)
+		return "", fmt.Errorf("contentful-api: received http status code %d when uploading app_bundle\n\n%s", res.StatusCode, string(body))
+	}
+
+	upload := struct {
+		Sys struct {
+			ID string `json:"id"`
+		} `json:"sys"`
+	}{}
+	err = json.NewDecoder(res.Body).Decode(&upload)
+	if err != nil {
+		return "", err
+	}
+
+	return upload.Sys.ID, nil
+}
+
+func (s *appBundleService) Create(ctx context.Context, organizationID string, appDefinitionID string, uploadID string, comment string) (map[string]interface{}, error) {
+	orgPath, err := s.c.orgPath(organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	path := orgPath + fmt.Sprintf("/app_definitions/%s/app_bundles", appDefinitionID)
+
+	body := map[string]interface{}{
+		"upload": map[string]interface{}{
+			"sys": map[string]interface{}{
+				"type":     "Link",
+				"linkType": "Upload",
+				"id":       uploadID,
+			},
+		},
+	}
+	if comment != "" {
+		body["comment"] = comment
+	}
+
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.c.do(ctx, "POST", path, 0, bytes.NewReader(bodyBytes))
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("contentful-api: received http status code %d when creating app_bundle\n\n%s", res.StatusCode, string(body))
+	}
+
+	resBody := make(map[string]interface{})
+	err = json.NewDecoder(res.Body).Decode(&resBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return resBody, nil
+}
+
+func (s *appBundleService) Read(ctx context.Context, organizationID string, appDefinitionID string, id string) (map[string]interface{}, error) {
+	orgPath, err := s.c.orgPath(organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	path := orgPath + fmt.Sprintf("/app_definitions/%s/app_bundles/%s", appDefinitionID, id)
+	res, err := s.c.do(ctx, "GET", path, 0, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("contentful-api: received http status code %d when reading app_bundle\n\n%s", res.StatusCode, string(body))
+	}
+
+	body := make(map[string]interface{})
+	err = json.NewDecoder(res.Body).Decode(&body)
+	if err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
+
+func (s *appBundleService) Delete(ctx context.Context, organizationID string, appDefinitionID string, id string) error {
+	orgPath, err := s.c.orgPath(organizationID)
+	if err != nil {
+		return err
+	}
+
+	path := orgPath + fmt.Sprintf("/app_definitions/%s/app_bundles/%s", appDefinitionID, id)
+	res, err := s.c.do(ctx, "DELETE", path, 0, nil)
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("contentful-api: received http status code %d when deleting app_bundle\n\n%s", res.StatusCode, string(body))
+	}
+
+	return nil
+}
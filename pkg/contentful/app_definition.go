@@ -0,0 +1,144 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+type IAppDefinitionService interface {
+	Create(ctx context.Context, organizationID string, body map[string]interface{}) (map[string]interface{}, error)
+	Read(ctx context.Context, organizationID string, id string) (map[string]interface{}, error)
+	Update(ctx context.Context, organizationID string, id string, version int, body map[string]interface{}) (map[string]interface{}, error)
+	Delete(ctx context.Context, organizationID string, id string) error
+}
+
+type appDefinitionService struct {
+	c *Client
+}
+
+func NewAppDefinitionService(c *Client) IAppDefinitionService {
+	return &appDefinitionService{c: c}
+}
+
+func (s *appDefinitionService) Create(ctx context.Context, organizationID string, body map[string]interface{}) (map[string]interface{}, error) {
+	orgPath, err := s.c.orgPath(organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.c.do(ctx, "POST", orgPath+"/app_definitions", 0, bytes.NewReader(bodyBytes))
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("contentful-api: received http status code %d when creating app_definition\n\n%s", res.StatusCode, string(body))
+	}
+
+	resBody := make(map[string]interface{})
+	err = json.NewDecoder(res.Body).Decode(&resBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return resBody, nil
+}
+
+func (s *appDefinitionService) Read(ctx context.Context, organizationID string, id string) (map[string]interface{}, error) {
+	orgPath, err := s.c.orgPath(organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	path := orgPath + fmt.Sprintf("/app_definitions/%s", id)
+	res, err := s.c.do(ctx, "GET", path, 0, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("contentful-api: received http status code %d when reading app_definition\n\n%s", res.StatusCode, string(body))
+	}
+
+	body := make(map[string]interface{})
+	err = json.NewDecoder(res.Body).Decode(&body)
+	if err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
+
+func (s *appDefinitionService) Update(ctx context.Context, organizationID string, id string, version int, body map[string]interface{}) (map[string]interface{}, error) {
+	orgPath, err := s.c.orgPath(organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	path := orgPath + fmt.Sprintf("/app_definitions/%s", id)
+
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.c.do(ctx, "PUT", path, version, bytes.NewReader(bodyBytes))
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("contentful-api: received http status code %d when updating app_definition\n\n%s", res.StatusCode, string(body))
+	}
+
+	resBody := make(map[string]interface{})
+	err = json.NewDecoder(res.Body).Decode(&resBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return resBody, nil
+}
+
+func (s *appDefinitionService) Delete(ctx context.Context, organizationID string, id string) error {
+	orgPath, err := s.c.orgPath(organizationID)
+	if err != nil {
+		return err
+	}
+
+	path := orgPath + fmt.Sprintf("/app_definitions/%s", id)
+	res, err := s.c.do(ctx, "DELETE", path, 0, nil)
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("contentful-api: received http status code %d when deleting app_definition\n\n%s", res.StatusCode, string(body))
+	}
+
+	return nil
+}
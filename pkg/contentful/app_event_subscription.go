@@ -0,0 +1,110 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+type IAppEventSubscriptionService interface {
+	Read(ctx context.Context, organizationID string, appDefinitionID string) (map[string]interface{}, error)
+	Put(ctx context.Context, organizationID string, appDefinitionID string, body map[string]interface{}) (map[string]interface{}, error)
+	Delete(ctx context.Context, organizationID string, appDefinitionID string) error
+}
+
+type appEventSubscriptionService struct {
+	c *Client
+}
+
+func NewAppEventSubscriptionService(c *Client) IAppEventSubscriptionService {
+	return &appEventSubscriptionService{c: c}
+}
+
+func (s *appEventSubscriptionService) Read(ctx context.Context, organizationID string, appDefinitionID string) (map[string]interface{}, error) {
+	orgPath, err := s.c.orgPath(organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	path := orgPath + fmt.Sprintf("/app_definitions/%s/event_subscription", appDefinitionID)
+	res, err := s.c.do(ctx, "GET", path, 0, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("contentful-api: received http status code %d when reading app_event_subscription\n\n%s", res.StatusCode, string(body))
+	}
+
+	body := make(map[string]interface{})
+	err = json.NewDecoder(res.Body).Decode(&body)
+	if err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
+
+func (s *appEventSubscriptionService) Put(ctx context.Context, organizationID string, appDefinitionID string, body map[string]interface{}) (map[string]interface{}, error) {
+	orgPath, err := s.c.orgPath(organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	path := orgPath + fmt.Sprintf("/app_definitions/%s/event_subscription", appDefinitionID)
+
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.c.do(ctx, "PUT", path, 0, bytes.NewReader(bodyBytes))
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("contentful-api: received http status code %d when updating app_event_subscription\n\n%s", res.StatusCode, string(body))
+	}
+
+	resBody := make(map[string]interface{})
+	err = json.NewDecoder(res.Body).Decode(&resBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return resBody, nil
+}
+
+func (s *appEventSubscriptionService) Delete(ctx context.Context, organizationID string, appDefinitionID string) error {
+	orgPath, err := s.c.orgPath(organizationID)
+	if err != nil {
+		return err
+	}
+
+	path := orgPath + fmt.Sprintf("/app_definitions/%s/event_subscription", appDefinitionID)
+	res, err := s.c.do(ctx, "DELETE", path, 0, nil)
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("contentful-api: received http status code %d when deleting app_event_subscription\n\n%s", res.StatusCode, string(body))
+	}
+
+	return nil
+}
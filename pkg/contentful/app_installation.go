@@ -0,0 +1,121 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// MarketplaceTerms are the values of the X-Contentful-Marketplace header that
+// accept the terms of marketplace apps when installing them.
+const MarketplaceTerms = "i-accept-end-user-license-agreement,i-accept-marketplace-terms-of-service,i-accept-privacy-policy"
+
+type IAppInstallationService interface {
+	Read(ctx context.Context, spaceID string, env string, appDefinitionID string) (map[string]interface{}, error)
+	Put(ctx context.Context, spaceID string, env string, appDefinitionID string, body map[string]interface{}, acceptTerms bool) (map[string]interface{}, error)
+	Delete(ctx context.Context, spaceID string, env string, appDefinitionID string) error
+}
+
+type appInstallationService struct {
+	c *Client
+}
+
+func NewAppInstallationService(c *Client) IAppInstallationService {
+	return &appInstallationService{c: c}
+}
+
+func (s *appInstallationService) Read(ctx context.Context, spaceID string, env string, appDefinitionID string) (map[string]interface{}, error) {
+	envPath, err := s.c.envPath(spaceID, env)
+	if err != nil {
+		return nil, err
+	}
+
+	path := envPath + fmt.Sprintf("/app_installations/%s", appDefinitionID)
+	res, err := s.c.do(ctx, "GET", path, 0, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("contentful-api: received http status code %d when reading app_installation\n\n%s", res.StatusCode, string(body))
+	}
+
+	body := make(map[string]interface{})
+	err = json.NewDecoder(res.Body).Decode(&body)
+	if err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
+
+// Put installs the app or updates its installation. acceptTerms accepts the
+// terms of marketplace apps, which they require to be installed.
+func (s *appInstallationService) Put(ctx context.Context, spaceID string, env string, appDefinitionID string, body map[string]interface{}, acceptTerms bool) (map[string]interface{}, error) {
+	envPath, err := s.c.envPath(spaceID, env)
+	if err != nil {
+		return nil, err
+	}
+
+	path := envPath + fmt.Sprintf("/app_installations/%s", appDefinitionID)
+
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	var headers map[string]string
+	if acceptTerms {
+		headers = map[string]string{"X-Contentful-Marketplace": MarketplaceTerms}
+	}
+
+	res, err := s.c.doWithHeaders(ctx, "PUT", path, 0, bytes.NewReader(bodyBytes), headers)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("contentful-api: received http status code %d when updating app_installation\n\n%s", res.StatusCode, string(body))
+	}
+
+	resBody := make(map[string]interface{})
+	err = json.NewDecoder(res.Body).Decode(&resBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return resBody, nil
+}
+
+func (s *appInstallationService) Delete(ctx context.Context, spaceID string, env string, appDefinitionID string) error {
+	envPath, err := s.c.envPath(spaceID, env)
+	if err != nil {
+		return err
+	}
+
+	path := envPath + fmt.Sprintf("/app_installations/%s", appDefinitionID)
+	res, err := s.c.do(ctx, "DELETE", path, 0, nil)
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("contentful-api: received http status code %d when deleting app_installation\n\n%s", res.StatusCode, string(body))
+	}
+
+	return nil
+}
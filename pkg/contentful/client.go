@@ -2,38 +2,110 @@ package contentful
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"math"
 	"net/http"
 	"strconv"
+	"sync"
 	"time"
 )
 
+var ErrMissingEnvironment = errors.New("contentful: no environment id given and no default environment configured")
+var ErrMissingOrganization = errors.New("contentful: no organization id given and no default organization configured")
+var ErrNotPublished = errors.New("contentful: content type is not published")
+
 type Client struct {
 	client         *http.Client
 	baseURL        string
+	uploadURL      string
 	token          string
 	organisationID string
+	spaceID        string
 	envID          string
 
-	ContentType IContentTypeService
+	views *envViews
+
+	ContentType          IContentTypeService
+	EditorInterface      IEditorInterfaceService
+	Tag                  ITagService
+	AppInstallation      IAppInstallationService
+	AppDefinition        IAppDefinitionService
+	AppBundle            IAppBundleService
+	AppSigningSecret     IAppSigningSecretService
+	AppEventSubscription IAppEventSubscriptionService
+	Extension            IExtensionService
+	ScheduledAction      IScheduledActionService
+}
+
+type envViews struct {
+	mu      sync.Mutex
+	clients map[string]*Client
 }
 
-func NewClient(token string, organisationID string, envID string) *Client {
+func NewClient(token string, organisationID string, spaceID string, envID string) *Client {
 	c := &Client{
 		client:         &http.Client{},
 		token:          token,
 		organisationID: organisationID,
+		spaceID:        spaceID,
 		envID:          envID,
 		baseURL:        "https://api.contentful.com",
+		uploadURL:      "https://upload.contentful.com",
+		views:          &envViews{clients: make(map[string]*Client)},
 	}
-	c.ContentType = NewContentTypeService(c)
+	c.initServices()
 
 	return c
 }
 
-func (c *Client) createRequest(ctx context.Context, method string, path string, version int, body io.Reader) (*http.Request, error) {
-	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+func (c *Client) initServices() {
+	c.ContentType = NewContentTypeService(c)
+	c.EditorInterface = NewEditorInterfaceService(c)
+	c.Tag = NewTagService(c)
+	c.AppInstallation = NewAppInstallationService(c)
+	c.AppDefinition = NewAppDefinitionService(c)
+	c.AppBundle = NewAppBundleService(c)
+	c.AppSigningSecret = NewAppSigningSecretService(c)
+	c.AppEventSubscription = NewAppEventSubscriptionService(c)
+	c.Extension = NewExtensionService(c)
+	c.ScheduledAction = NewScheduledActionService(c)
+}
+
+// Env returns a view of the client whose default environment is envID. Views
+// share the underlying http client and credentials and are cached, so calling
+// Env repeatedly with the same id returns the same view.
+func (c *Client) Env(envID string) *Client {
+	if envID == "" || envID == c.envID {
+		return c
+	}
+
+	c.views.mu.Lock()
+	defer c.views.mu.Unlock()
+
+	if view, ok := c.views.clients[envID]; ok {
+		return view
+	}
+
+	view := &Client{
+		client:         c.client,
+		baseURL:        c.baseURL,
+		uploadURL:      c.uploadURL,
+		token:          c.token,
+		organisationID: c.organisationID,
+		spaceID:        c.spaceID,
+		envID:          envID,
+		views:          c.views,
+	}
+	view.initServices()
+	c.views.clients[envID] = view
+
+	return view
+}
+
+func (c *Client) createRequest(ctx context.Context, method string, url string, version int, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
 		return nil, err
 	}
@@ -50,11 +122,29 @@ func (c *Client) createRequest(ctx context.Context, method string, path string,
 }
 
 func (c *Client) do(ctx context.Context, method string, path string, version int, body io.Reader) (*http.Response, error) {
-	req, err := c.createRequest(ctx, method, path, version, body)
+	return c.doWithHeaders(ctx, method, path, version, body, nil)
+}
+
+// doWithHeaders is do with additional request headers.
+func (c *Client) doWithHeaders(ctx context.Context, method string, path string, version int, body io.Reader, headers map[string]string) (*http.Response, error) {
+	return c.doURL(ctx, method, c.baseURL+path, version, body, headers)
+}
+
+// upload sends body to the upload API.
+func (c *Client) upload(ctx context.Context, path string, body io.Reader) (*http.Response, error) {
+	return c.doURL(ctx, "POST", c.uploadURL+path, 0, body, map[string]string{"Content-Type": "application/octet-stream"})
+}
+
+func (c *Client) doURL(ctx context.Context, method string, url string, version int, body io.Reader, headers map[string]string) (*http.Response, error) {
+	req, err := c.createRequest(ctx, method, url, version, body)
 	if err != nil {
 		return nil, err
 	}
 
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
 	for attempt := 1; attempt <= 3; attempt++ {
 		res, err := c.client.Do(req)
 		if err != nil {
@@ -81,6 +171,36 @@ func (c *Client) do(ctx context.Context, method string, path string, version int
 	return nil, nil
 }
 
+// ResolveSpace returns space, or the client's default space when space is empty.
+func (c *Client) ResolveSpace(space string) string {
+	if space == "" {
+		return c.spaceID
+	}
+	return space
+}
+
+// ResolveEnv returns env, or the client's default environment when env is empty.
+func (c *Client) ResolveEnv(env string) string {
+	return c.getEnv(env)
+}
+
+// ResolveOrganization returns organizationID, or the client's organization
+// when organizationID is empty.
+func (c *Client) ResolveOrganization(organizationID string) string {
+	if organizationID == "" {
+		return c.organisationID
+	}
+	return organizationID
+}
+
+func (c *Client) orgPath(organizationID string) (string, error) {
+	organizationID = c.ResolveOrganization(organizationID)
+	if organizationID == "" {
+		return "", ErrMissingOrganization
+	}
+	return fmt.Sprintf("/organizations/%s", organizationID), nil
+}
+
 func (c *Client) getEnv(env string) string {
 	envID := env
 	if envID == "" {
@@ -88,3 +208,11 @@ func (c *Client) getEnv(env string) string {
 	}
 	return envID
 }
+
+func (c *Client) envPath(spaceID string, env string) (string, error) {
+	envID := c.getEnv(env)
+	if envID == "" {
+		return "", ErrMissingEnvironment
+	}
+	return fmt.Sprintf("/spaces/%s/environments/%s", spaceID, envID), nil
+}
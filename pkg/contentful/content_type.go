@@ -6,11 +6,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/url"
 )
 
 type IContentTypeService interface {
 	Activate(ctx context.Context, spaceID string, env string, id string, version int) (map[string]interface{}, error)
 	Read(ctx context.Context, spaceID string, env string, id string) (map[string]interface{}, error)
+	ReadPublished(ctx context.Context, spaceID string, env string, id string) (map[string]interface{}, error)
+	List(ctx context.Context, spaceID string, env string) ([]map[string]interface{}, error)
 	Put(ctx context.Context, spaceID string, env string, id string, version int, body map[string]interface{}) (map[string]interface{}, error)
 }
 
@@ -23,7 +26,12 @@ func NewContentTypeService(c *Client) IContentTypeService {
 }
 
 func (s *contentTypeService) Activate(ctx context.Context, spaceID string, env string, id string, version int) (map[string]interface{}, error) {
-	path := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s/published", spaceID, s.c.getEnv(env), id)
+	envPath, err := s.c.envPath(spaceID, env)
+	if err != nil {
+		return nil, err
+	}
+
+	path := envPath + fmt.Sprintf("/content_types/%s/published", id)
 	res, err := s.c.do(ctx, "PUT", path, version, nil)
 
 	if err != nil {
@@ -47,7 +55,12 @@ func (s *contentTypeService) Activate(ctx context.Context, spaceID string, env s
 }
 
 func (s *contentTypeService) Read(ctx context.Context, spaceID string, env string, id string) (map[string]interface{}, error) {
-	path := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s", spaceID, s.c.getEnv(env), id)
+	envPath, err := s.c.envPath(spaceID, env)
+	if err != nil {
+		return nil, err
+	}
+
+	path := envPath + fmt.Sprintf("/content_types/%s", id)
 	res, err := s.c.do(ctx, "GET", path, 0, nil)
 	if err != nil {
 		return nil, err
@@ -68,8 +81,94 @@ func (s *contentTypeService) Read(ctx context.Context, spaceID string, env strin
 	return body, nil
 }
 
+func (s *contentTypeService) List(ctx context.Context, spaceID string, env string) ([]map[string]interface{}, error) {
+	envPath, err := s.c.envPath(spaceID, env)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.list(ctx, envPath+"/content_types")
+}
+
+// ReadPublished returns the published version of the content type, or an
+// error wrapping ErrNotPublished when it is not published.
+func (s *contentTypeService) ReadPublished(ctx context.Context, spaceID string, env string, id string) (map[string]interface{}, error) {
+	envPath, err := s.c.envPath(spaceID, env)
+	if err != nil {
+		return nil, err
+	}
+
+	path := envPath + "/public/content_types?sys.id=" + url.QueryEscape(id)
+	res, err := s.c.do(ctx, "GET", path, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("contentful-api: received http status code %d when reading published content_type\n\n%s", res.StatusCode, string(body))
+	}
+
+	page := struct {
+		Items []map[string]interface{} `json:"items"`
+	}{}
+	err = json.NewDecoder(res.Body).Decode(&page)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ct := range page.Items {
+		if sys, ok := ct["sys"].(map[string]interface{}); ok && sys["id"] == id {
+			return ct, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrNotPublished, id)
+}
+
+func (s *contentTypeService) list(ctx context.Context, basePath string) ([]map[string]interface{}, error) {
+	const limit = 100
+	result := make([]map[string]interface{}, 0)
+
+	for skip := 0; ; skip += limit {
+		path := basePath + fmt.Sprintf("?limit=%d&skip=%d&order=sys.id", limit, skip)
+		res, err := s.c.do(ctx, "GET", path, 0, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if res.StatusCode >= 400 {
+			body, _ := io.ReadAll(res.Body)
+			res.Body.Close()
+			return nil, fmt.Errorf("contentful-api: received http status code %d when listing content_types\n\n%s", res.StatusCode, string(body))
+		}
+
+		page := struct {
+			Total int                      `json:"total"`
+			Items []map[string]interface{} `json:"items"`
+		}{}
+		err = json.NewDecoder(res.Body).Decode(&page)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, page.Items...)
+
+		if len(page.Items) == 0 || skip+len(page.Items) >= page.Total {
+			return result, nil
+		}
+	}
+}
+
 func (s *contentTypeService) Put(ctx context.Context, spaceID string, env string, id string, version int, body map[string]interface{}) (map[string]interface{}, error) {
-	path := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s", spaceID, s.c.getEnv(env), id)
+	envPath, err := s.c.envPath(spaceID, env)
+	if err != nil {
+		return nil, err
+	}
+
+	path := envPath + fmt.Sprintf("/content_types/%s", id)
 
 	bodyBytes, err := json.Marshal(body)
 	if err != nil {
@@ -0,0 +1,41 @@
+package contentful
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestContentTypeReadPublished(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/spaces/space/environments/master/public/content_types" {
+			t.Errorf("got path %s", r.URL.Path)
+		}
+
+		switch r.URL.Query().Get("sys.id") {
+		case "blog":
+			w.Write([]byte(`{"total":1,"items":[{"sys":{"id":"blog","version":3}}]}`))
+		default:
+			w.Write([]byte(`{"total":0,"items":[]}`))
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient("token", "org", "space", "master")
+	c.baseURL = server.URL
+
+	ct, err := c.ContentType.ReadPublished(context.Background(), "space", "master", "blog")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ct["sys"].(map[string]interface{})["id"] != "blog" {
+		t.Errorf("got %v", ct)
+	}
+
+	_, err = c.ContentType.ReadPublished(context.Background(), "space", "master", "draft")
+	if !errors.Is(err, ErrNotPublished) {
+		t.Errorf("got error %v, want ErrNotPublished", err)
+	}
+}
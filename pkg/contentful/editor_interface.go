@@ -0,0 +1,86 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+type IEditorInterfaceService interface {
+	Read(ctx context.Context, spaceID string, env string, contentTypeID string) (map[string]interface{}, error)
+	Put(ctx context.Context, spaceID string, env string, contentTypeID string, version int, body map[string]interface{}) (map[string]interface{}, error)
+}
+
+type editorInterfaceService struct {
+	c *Client
+}
+
+func NewEditorInterfaceService(c *Client) IEditorInterfaceService {
+	return &editorInterfaceService{c: c}
+}
+
+func (s *editorInterfaceService) Read(ctx context.Context, spaceID string, env string, contentTypeID string) (map[string]interface{}, error) {
+	envPath, err := s.c.envPath(spaceID, env)
+	if err != nil {
+		return nil, err
+	}
+
+	path := envPath + fmt.Sprintf("/content_types/%s/editor_interface", contentTypeID)
+	res, err := s.c.do(ctx, "GET", path, 0, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("contentful-api: received http status code %d when reading editor_interface\n\n%s", res.StatusCode, string(body))
+	}
+
+	body := make(map[string]interface{})
+	err = json.NewDecoder(res.Body).Decode(&body)
+	if err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
+
+func (s *editorInterfaceService) Put(ctx context.Context, spaceID string, env string, contentTypeID string, version int, body map[string]interface{}) (map[string]interface{}, error) {
+	envPath, err := s.c.envPath(spaceID, env)
+	if err != nil {
+		return nil, err
+	}
+
+	path := envPath + fmt.Sprintf("/content_types/%s/editor_interface", contentTypeID)
+
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.c.do(ctx, "PUT", path, version, bytes.NewReader(bodyBytes))
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("contentful-api: received http status code %d when updating editor_interface\n\n%s", res.StatusCode, string(body))
+	}
+
+	resBody := make(map[string]interface{})
+	err = json.NewDecoder(res.Body).Decode(&resBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return resBody, nil
+}
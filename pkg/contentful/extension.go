@@ -0,0 +1,111 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+type IExtensionService interface {
+	Read(ctx context.Context, spaceID string, env string, id string) (map[string]interface{}, error)
+	Put(ctx context.Context, spaceID string, env string, id string, version int, body map[string]interface{}) (map[string]interface{}, error)
+	Delete(ctx context.Context, spaceID string, env string, id string, version int) error
+}
+
+type extensionService struct {
+	c *Client
+}
+
+func NewExtensionService(c *Client) IExtensionService {
+	return &extensionService{c: c}
+}
+
+func (s *extensionService) Read(ctx context.Context, spaceID string, env string, id string) (map[string]interface{}, error) {
+	envPath, err := s.c.envPath(spaceID, env)
+	if err != nil {
+		return nil, err
+	}
+
+	path := envPath + fmt.Sprintf("/extensions/%s", id)
+	res, err := s.c.do(ctx, "GET", path, 0, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("contentful-api: received http status code %d when reading extension\n\n%s", res.StatusCode, string(body))
+	}
+
+	body := make(map[string]interface{})
+	err = json.NewDecoder(res.Body).Decode(&body)
+	if err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
+
+// Put creates the extension when version is 0 and updates it otherwise.
+func (s *extensionService) Put(ctx context.Context, spaceID string, env string, id string, version int, body map[string]interface{}) (map[string]interface{}, error) {
+	envPath, err := s.c.envPath(spaceID, env)
+	if err != nil {
+		return nil, err
+	}
+
+	path := envPath + fmt.Sprintf("/extensions/%s", id)
+
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.c.do(ctx, "PUT", path, version, bytes.NewReader(bodyBytes))
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("contentful-api: received http status code %d when updating extension\n\n%s", res.StatusCode, string(body))
+	}
+
+	resBody := make(map[string]interface{})
+	err = json.NewDecoder(res.Body).Decode(&resBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return resBody, nil
+}
+
+func (s *extensionService) Delete(ctx context.Context, spaceID string, env string, id string, version int) error {
+	envPath, err := s.c.envPath(spaceID, env)
+	if err != nil {
+		return err
+	}
+
+	path := envPath + fmt.Sprintf("/extensions/%s", id)
+	res, err := s.c.do(ctx, "DELETE", path, version, nil)
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("contentful-api: received http status code %d when deleting extension\n\n%s", res.StatusCode, string(body))
+	}
+
+	return nil
+}
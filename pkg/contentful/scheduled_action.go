@@ -0,0 +1,157 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+)
+
+type IScheduledActionService interface {
+	Create(ctx context.Context, spaceID string, env string, body map[string]interface{}) (map[string]interface{}, error)
+	Read(ctx context.Context, spaceID string, env string, id string) (map[string]interface{}, error)
+	Update(ctx context.Context, spaceID string, env string, id string, version int, body map[string]interface{}) (map[string]interface{}, error)
+	Cancel(ctx context.Context, spaceID string, env string, id string) error
+}
+
+type scheduledActionService struct {
+	c *Client
+}
+
+func NewScheduledActionService(c *Client) IScheduledActionService {
+	return &scheduledActionService{c: c}
+}
+
+// path returns the path of the scheduled actions of the space, or of the
+// scheduled action id when it is set. Scheduled actions are space scoped and
+// select their environment with a query parameter.
+func (s *scheduledActionService) path(spaceID string, env string, id string) (string, error) {
+	envID := s.c.getEnv(env)
+	if envID == "" {
+		return "", ErrMissingEnvironment
+	}
+
+	path := fmt.Sprintf("/spaces/%s/scheduled_actions", spaceID)
+	if id != "" {
+		path += "/" + id
+	}
+	return path + "?environment.sys.id=" + url.QueryEscape(envID), nil
+}
+
+func (s *scheduledActionService) Create(ctx context.Context, spaceID string, env string, body map[string]interface{}) (map[string]interface{}, error) {
+	path, err := s.path(spaceID, env, "")
+	if err != nil {
+		return nil, err
+	}
+
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.c.do(ctx, "POST", path, 0, bytes.NewReader(bodyBytes))
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("contentful-api: received http status code %d when creating scheduled_action\n\n%s", res.StatusCode, string(body))
+	}
+
+	resBody := make(map[string]interface{})
+	err = json.NewDecoder(res.Body).Decode(&resBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return resBody, nil
+}
+
+func (s *scheduledActionService) Read(ctx context.Context, spaceID string, env string, id string) (map[string]interface{}, error) {
+	path, err := s.path(spaceID, env, id)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.c.do(ctx, "GET", path, 0, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("contentful-api: received http status code %d when reading scheduled_action\n\n%s", res.StatusCode, string(body))
+	}
+
+	body := make(map[string]interface{})
+	err = json.NewDecoder(res.Body).Decode(&body)
+	if err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
+
+func (s *scheduledActionService) Update(ctx context.Context, spaceID string, env string, id string, version int, body map[string]interface{}) (map[string]interface{}, error) {
+	path, err := s.path(spaceID, env, id)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.c.do(ctx, "PUT", path, version, bytes.NewReader(bodyBytes))
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("contentful-api: received http status code %d when updating scheduled_action\n\n%s", res.StatusCode, string(body))
+	}
+
+	resBody := make(map[string]interface{})
+	err = json.NewDecoder(res.Body).Decode(&resBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return resBody, nil
+}
+
+func (s *scheduledActionService) Cancel(ctx context.Context, spaceID string, env string, id string) error {
+	path, err := s.path(spaceID, env, id)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.c.do(ctx, "DELETE", path, 0, nil)
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("contentful-api: received http status code %d when canceling scheduled_action\n\n%s", res.StatusCode, string(body))
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+)
+
+type ITagService interface {
+	Read(ctx context.Context, spaceID string, env string, id string) (map[string]interface{}, error)
+	Put(ctx context.Context, spaceID string, env string, id string, version int, body map[string]interface{}) (map[string]interface{}, error)
+	Delete(ctx context.Context, spaceID string, env string, id string, version int) error
+	CountEntries(ctx context.Context, spaceID string, env string, id string) (int, error)
+}
+
+type tagService struct {
+	c *Client
+}
+
+func NewTagService(c *Client) ITagService {
+	return &tagService{c: c}
+}
+
+func (s *tagService) Read(ctx context.Context, spaceID string, env string, id string) (map[string]interface{}, error) {
+	envPath, err := s.c.envPath(spaceID, env)
+	if err != nil {
+		return nil, err
+	}
+
+	path := envPath + fmt.Sprintf("/tags/%s", id)
+	res, err := s.c.do(ctx, "GET", path, 0, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("contentful-api: received http status code %d when reading tag\n\n%s", res.StatusCode, string(body))
+	}
+
+	body := make(map[string]interface{})
+	err = json.NewDecoder(res.Body).Decode(&body)
+	if err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
+
+// Put creates the tag when version is 0 and updates it otherwise.
+func (s *tagService) Put(ctx context.Context, spaceID string, env string, id string, version int, body map[string]interface{}) (map[string]interface{}, error) {
+	envPath, err := s.c.envPath(spaceID, env)
+	if err != nil {
+		return nil, err
+	}
+
+	path := envPath + fmt.Sprintf("/tags/%s", id)
+
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.c.do(ctx, "PUT", path, version, bytes.NewReader(bodyBytes))
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("contentful-api: received http status code %d when updating tag\n\n%s", res.StatusCode, string(body))
+	}
+
+	resBody := make(map[string]interface{})
+	err = json.NewDecoder(res.Body).Decode(&resBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return resBody, nil
+}
+
+func (s *tagService) Delete(ctx context.Context, spaceID string, env string, id string, version int) error {
+	envPath, err := s.c.envPath(spaceID, env)
+	if err != nil {
+		return err
+	}
+
+	path := envPath + fmt.Sprintf("/tags/%s", id)
+	res, err := s.c.do(ctx, "DELETE", path, version, nil)
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("contentful-api: received http status code %d when deleting tag\n\n%s", res.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// CountEntries returns the number of entries tagged with the tag.
+func (s *tagService) CountEntries(ctx context.Context, spaceID string, env string, id string) (int, error) {
+	envPath, err := s.c.envPath(spaceID, env)
+	if err != nil {
+		return 0, err
+	}
+
+	path := envPath + fmt.Sprintf("/entries?metadata.tags.sys.id[in]=%s&limit=0", url.QueryEscape(id))
+	res, err := s.c.do(ctx, "GET", path, 0, nil)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, _ := io.ReadAll(res.Body)
+		return 0, fmt.Errorf("contentful-api: received http status code %d when listing entries of tag\n\n%s", res.StatusCode, string(body))
+	}
+
+	page := struct {
+		Total int `json:"total"`
+	}{}
+	err = json.NewDecoder(res.Body).Decode(&page)
+	if err != nil {
+		return 0, err
+	}
+
+	return page.Total, nil
+}